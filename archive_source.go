@@ -0,0 +1,304 @@
+package configo
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"io"
+	"io/fs"
+	"io/ioutil"
+	"log"
+	"path"
+	"sort"
+	"strings"
+	"time"
+)
+
+// ArchiveSource reads configuration from a tar, tar.gz (tgz) or zip archive. The archive
+// is either served with DirectorySource semantics (each file in a directory of the archive
+// becomes a key) or a single file within the archive is parsed as JSON content.
+type ArchiveSource struct {
+	filename  string
+	mustExist bool
+	directory string
+	jsonFile  string
+	inner     Source
+}
+
+// FromArchive serves the files found in the given directory of the archive as keys, just
+// like a DirectorySource. Use "." for the root of the archive. If the archive or directory
+// does not exist, a panic will result.
+func FromArchive(filename, directory string) *ArchiveSource {
+	return &ArchiveSource{filename: filename, directory: directory, mustExist: true}
+}
+
+// FromOptionalArchive is like FromArchive but it does not panic if the archive is not found.
+func FromOptionalArchive(filename, directory string) *ArchiveSource {
+	return &ArchiveSource{filename: filename, directory: directory}
+}
+
+// FromArchiveJSONFile parses the named JSON file within the archive. If the archive or the
+// file does not exist, a panic will result.
+func FromArchiveJSONFile(filename, jsonFile string) *ArchiveSource {
+	return &ArchiveSource{filename: filename, jsonFile: jsonFile, mustExist: true}
+}
+
+// FromOptionalArchiveJSONFile is like FromArchiveJSONFile but it does not panic if the archive
+// or the file within it is not found.
+func FromOptionalArchiveJSONFile(filename, jsonFile string) *ArchiveSource {
+	return &ArchiveSource{filename: filename, jsonFile: jsonFile}
+}
+
+// Initialize reads the archive and prepares the inner source.
+func (this *ArchiveSource) Initialize() {
+	fsys, err := openArchive(this.filename)
+	if err != nil {
+		log.Printf("[INFO] archive not read [%s]: %s\n", this.filename, err)
+		if this.mustExist {
+			panic("archive must exist")
+		}
+		return
+	}
+
+	if len(this.jsonFile) > 0 && this.mustExist {
+		this.inner = FromJSONFileFS(fsys, this.jsonFile)
+	} else if len(this.jsonFile) > 0 {
+		this.inner = FromOptionalJSONFileFS(fsys, this.jsonFile)
+	} else if this.mustExist {
+		this.inner = FromDirectoryFS(fsys, this.directory)
+	} else {
+		this.inner = FromOptionalDirectoryFS(fsys, this.directory)
+	}
+
+	if !isNil(this.inner) {
+		this.inner.Initialize()
+	}
+}
+
+// Strings reads the key from the inner source if the archive was successfully loaded during Initialize.
+func (this *ArchiveSource) Strings(key string) ([]string, error) {
+	if isNil(this.inner) {
+		return nil, ErrKeyNotFound
+	}
+	return this.inner.Strings(key)
+}
+
+// StringMap reads the nested object from the inner source (see MapSource) if the archive was successfully
+// loaded during Initialize.
+func (this *ArchiveSource) StringMap(key string) (map[string]string, error) {
+	if hierarchical, ok := this.inner.(MapSource); ok && !isNil(this.inner) {
+		return hierarchical.StringMap(key)
+	}
+	return nil, ErrKeyNotFound
+}
+
+/* ////////////////////////////////////////////////////////////////////////////////////////////////////////////////// */
+
+var errUnknownArchiveFormat = errors.New("unknown archive format (expected .tar, .tar.gz, .tgz, or .zip)")
+
+func openArchive(filename string) (archiveFS, error) {
+	contents, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	lower := strings.ToLower(filename)
+	switch {
+	case strings.HasSuffix(lower, ".zip"):
+		return readZip(contents)
+	case strings.HasSuffix(lower, ".tar.gz"), strings.HasSuffix(lower, ".tgz"):
+		reader, err := gzip.NewReader(bytes.NewReader(contents))
+		if err != nil {
+			return nil, err
+		}
+		return readTar(reader)
+	case strings.HasSuffix(lower, ".tar"):
+		return readTar(bytes.NewReader(contents))
+	default:
+		return nil, errUnknownArchiveFormat
+	}
+}
+
+func readTar(reader io.Reader) (archiveFS, error) {
+	files := make(archiveFS)
+	archive := tar.NewReader(reader)
+	for {
+		header, err := archive.Next()
+		if err == io.EOF {
+			return files, nil
+		} else if err != nil {
+			return nil, err
+		}
+
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+
+		contents, err := ioutil.ReadAll(archive)
+		if err != nil {
+			return nil, err
+		}
+		files.add(header.Name, contents)
+	}
+}
+
+func readZip(contents []byte) (archiveFS, error) {
+	archive, err := zip.NewReader(bytes.NewReader(contents), int64(len(contents)))
+	if err != nil {
+		return nil, err
+	}
+
+	files := make(archiveFS)
+	for _, file := range archive.File {
+		if file.FileInfo().IsDir() {
+			continue
+		}
+
+		reader, err := file.Open()
+		if err != nil {
+			return nil, err
+		}
+		contents, err := ioutil.ReadAll(reader)
+		_ = reader.Close()
+		if err != nil {
+			return nil, err
+		}
+		files.add(file.Name, contents)
+	}
+	return files, nil
+}
+
+/* ////////////////////////////////////////////////////////////////////////////////////////////////////////////////// */
+
+// archiveFS is an in-memory, read-only fs.FS of the regular files extracted from an archive.
+type archiveFS map[string][]byte
+
+func (this archiveFS) add(name string, contents []byte) {
+	name = path.Clean(strings.TrimPrefix(name, "/"))
+	if fs.ValidPath(name) {
+		this[name] = contents
+	}
+}
+
+func (this archiveFS) Open(name string) (fs.File, error) {
+	if !fs.ValidPath(name) {
+		return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrInvalid}
+	}
+	if contents, found := this[name]; found {
+		return &archiveFile{entry: archiveEntry{name: path.Base(name), size: int64(len(contents))}, reader: bytes.NewReader(contents), path: name}, nil
+	}
+	if this.isDir(name) {
+		return &archiveFile{entry: archiveEntry{name: path.Base(name), dir: true}, path: name, fsys: this}, nil
+	}
+	return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrNotExist}
+}
+
+func (this archiveFS) ReadFile(name string) ([]byte, error) {
+	if contents, found := this[name]; found {
+		return append([]byte(nil), contents...), nil
+	}
+	return nil, &fs.PathError{Op: "read", Path: name, Err: fs.ErrNotExist}
+}
+
+func (this archiveFS) ReadDir(name string) ([]fs.DirEntry, error) {
+	if !this.isDir(name) {
+		return nil, &fs.PathError{Op: "readdir", Path: name, Err: fs.ErrNotExist}
+	}
+
+	children := make(map[string]archiveEntry)
+	for filename, contents := range this {
+		relative, inside := this.relative(name, filename)
+		if !inside {
+			continue
+		}
+		if slash := strings.Index(relative, "/"); slash >= 0 {
+			children[relative[:slash]] = archiveEntry{name: relative[:slash], dir: true}
+		} else {
+			children[relative] = archiveEntry{name: relative, size: int64(len(contents))}
+		}
+	}
+
+	entries := make([]fs.DirEntry, 0, len(children))
+	for _, child := range children {
+		entries = append(entries, child)
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Name() < entries[j].Name() })
+	return entries, nil
+}
+
+func (this archiveFS) isDir(name string) bool {
+	if name == "." {
+		return true
+	}
+	for filename := range this {
+		if _, inside := this.relative(name, filename); inside {
+			return true
+		}
+	}
+	return false
+}
+
+func (this archiveFS) relative(directory, filename string) (string, bool) {
+	if directory == "." {
+		return filename, true
+	}
+	prefix := directory + "/"
+	return strings.TrimPrefix(filename, prefix), strings.HasPrefix(filename, prefix)
+}
+
+type archiveFile struct {
+	entry  archiveEntry
+	reader *bytes.Reader
+	path   string
+	fsys   archiveFS
+	offset int
+}
+
+func (this *archiveFile) Stat() (fs.FileInfo, error) { return this.entry, nil }
+func (this *archiveFile) Close() error               { return nil }
+
+func (this *archiveFile) Read(p []byte) (int, error) {
+	if this.entry.dir {
+		return 0, &fs.PathError{Op: "read", Path: this.path, Err: fs.ErrInvalid}
+	}
+	return this.reader.Read(p)
+}
+
+func (this *archiveFile) ReadDir(count int) ([]fs.DirEntry, error) {
+	if !this.entry.dir {
+		return nil, &fs.PathError{Op: "readdir", Path: this.path, Err: fs.ErrInvalid}
+	}
+
+	entries, _ := this.fsys.ReadDir(this.path)
+	entries = entries[this.offset:]
+	if count > 0 && len(entries) == 0 {
+		return nil, io.EOF
+	}
+	if count > 0 && count < len(entries) {
+		entries = entries[:count]
+	}
+	this.offset += len(entries)
+	return entries, nil
+}
+
+type archiveEntry struct {
+	name string
+	size int64
+	dir  bool
+}
+
+func (this archiveEntry) Name() string               { return this.name }
+func (this archiveEntry) Size() int64                { return this.size }
+func (this archiveEntry) ModTime() time.Time         { return time.Time{} }
+func (this archiveEntry) IsDir() bool                { return this.dir }
+func (this archiveEntry) Sys() interface{}           { return nil }
+func (this archiveEntry) Type() fs.FileMode          { return this.Mode().Type() }
+func (this archiveEntry) Info() (fs.FileInfo, error) { return this, nil }
+func (this archiveEntry) Mode() fs.FileMode {
+	if this.dir {
+		return fs.ModeDir | 0555
+	}
+	return 0444
+}
@@ -0,0 +1,137 @@
+package configo
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"io/ioutil"
+	"testing"
+	"testing/fstest"
+
+	"github.com/smartystreets/assertions/should"
+	"github.com/smartystreets/gunit"
+)
+
+func TestArchiveSourceFixture(t *testing.T) {
+	gunit.Run(new(ArchiveSourceFixture), t)
+}
+
+type ArchiveSourceFixture struct {
+	*gunit.Fixture
+
+	directory tempDirectory
+	files     map[string]string
+}
+
+func (this *ArchiveSourceFixture) Setup() {
+	this.directory = newTempDirectory("archiveSrc")
+	this.files = map[string]string{
+		"config/app.json":     `{"listen":":8080"}`,
+		"secrets/Db-Password": "hunter2",
+		"secrets/api-key":     "abc123",
+	}
+}
+
+func (this *ArchiveSourceFixture) Teardown() {
+	this.directory.remove()
+}
+
+func (this *ArchiveSourceFixture) TestDirectoryFromTarGz() {
+	source := FromArchive(this.writeTar("bundle.tar.gz", true), "secrets")
+	source.Initialize()
+
+	this.assertValue(source, "db-password", "hunter2")
+	this.assertValue(source, "API_KEY", "abc123")
+}
+
+func (this *ArchiveSourceFixture) TestDirectoryFromTar() {
+	filename := this.writeTar("bundle.tar", false)
+	contents, _ := ioutil.ReadFile(filename)
+	this.So(bytes.HasPrefix(contents, []byte{0x1f, 0x8b}), should.BeFalse) // not gzipped
+
+	source := FromArchive(filename, "secrets")
+	source.Initialize()
+
+	this.assertValue(source, "db-password", "hunter2")
+}
+
+func (this *ArchiveSourceFixture) TestJSONFileFromZip() {
+	source := FromArchiveJSONFile(this.writeZip("bundle.zip"), "config/app.json")
+	source.Initialize()
+
+	this.assertValue(source, "listen", ":8080")
+}
+
+func (this *ArchiveSourceFixture) TestMissingArchive() {
+	filename := this.directory.path("missing.tar.gz")
+
+	this.So(func() { FromArchive(filename, ".").Initialize() }, should.Panic)
+	this.So(func() { FromArchiveJSONFile(filename, "app.json").Initialize() }, should.Panic)
+
+	source := FromOptionalArchive(filename, ".")
+	this.So(source.Initialize, should.NotPanic)
+	values, err := source.Strings("key")
+	this.So(values, should.BeNil)
+	this.So(err, should.Equal, ErrKeyNotFound)
+}
+
+func (this *ArchiveSourceFixture) TestMissingJSONFileInOptionalArchive() {
+	source := FromOptionalArchiveJSONFile(this.writeZip("bundle.zip"), "missing.json")
+	this.So(source.Initialize, should.NotPanic)
+
+	_, err := source.Strings("listen")
+	this.So(err, should.Equal, ErrKeyNotFound)
+}
+
+func (this *ArchiveSourceFixture) TestUnknownFormat() {
+	filename := this.directory.write("bundle.rar", []byte("not an archive"))
+
+	this.So(func() { FromArchive(filename, ".").Initialize() }, should.Panic)
+}
+
+func (this *ArchiveSourceFixture) TestArchiveFSConformance() {
+	fsys := make(archiveFS)
+	for name, contents := range this.files {
+		fsys.add(name, []byte(contents))
+	}
+
+	this.So(fstest.TestFS(fsys, "config/app.json", "secrets/Db-Password", "secrets/api-key"), should.BeNil)
+}
+
+func (this *ArchiveSourceFixture) assertValue(source Source, key, expected string) {
+	values, err := source.Strings(key)
+	this.So(err, should.BeNil)
+	this.So(values, should.Resemble, []string{expected})
+}
+
+func (this *ArchiveSourceFixture) writeTar(name string, compressed bool) string {
+	buffer := new(bytes.Buffer)
+	writer := tar.NewWriter(buffer)
+	for filename, contents := range this.files {
+		_ = writer.WriteHeader(&tar.Header{Name: filename, Mode: 0600, Size: int64(len(contents)), Typeflag: tar.TypeReg})
+		_, _ = writer.Write([]byte(contents))
+	}
+	_ = writer.Close()
+
+	if !compressed {
+		return this.directory.write(name, buffer.Bytes())
+	}
+
+	gzipped := new(bytes.Buffer)
+	gzipWriter := gzip.NewWriter(gzipped)
+	_, _ = gzipWriter.Write(buffer.Bytes())
+	_ = gzipWriter.Close()
+	return this.directory.write(name, gzipped.Bytes())
+}
+
+func (this *ArchiveSourceFixture) writeZip(name string) string {
+	buffer := new(bytes.Buffer)
+	writer := zip.NewWriter(buffer)
+	for filename, contents := range this.files {
+		file, _ := writer.Create(filename)
+		_, _ = file.Write([]byte(contents))
+	}
+	_ = writer.Close()
+	return this.directory.write(name, buffer.Bytes())
+}
@@ -0,0 +1,105 @@
+package configo
+
+import (
+	"fmt"
+	"strings"
+)
+
+// cliArgument is a named positional argument of a CLISource.
+type cliArgument struct {
+	name        string
+	description string
+	required    bool
+	variadic    bool
+	values      []string
+}
+
+// Argument registers a required, named positional argument (supplied after any flags).
+// Its value may be read using the name as a key. Positional arguments can't be registered
+// alongside subcommands (see Command), but a subcommand may register its own.
+func Argument(name, description string) CLI {
+	return registerArgument(cliArgument{name: name, description: description, required: true})
+}
+
+// OptionalArgument registers an optional, named positional argument. Optional arguments
+// must be registered after all required arguments.
+func OptionalArgument(name, description string) CLI {
+	return registerArgument(cliArgument{name: name, description: description})
+}
+
+// VariadicArgument registers a named positional argument which receives all remaining arguments
+// (zero or more) as its values. It must be the last argument registered.
+func VariadicArgument(name, description string) CLI {
+	return registerArgument(cliArgument{name: name, description: description, variadic: true})
+}
+
+func registerArgument(template cliArgument) CLI {
+	return func(this *CLISource) {
+		if len(this.commands) > 0 {
+			panic(exclusiveArgumentsAndCommands)
+		}
+		if count := len(this.positionals); count > 0 {
+			previous := this.positionals[count-1]
+			if previous.variadic {
+				panic("a variadic argument must be the last argument registered")
+			}
+			if template.required && !previous.required {
+				panic("required arguments must be registered before optional arguments")
+			}
+		}
+
+		positional := new(cliArgument)
+		*positional = template
+		this.positionals = append(this.positionals, positional)
+	}
+}
+
+const exclusiveArgumentsAndCommands = "positional arguments and subcommands can't be registered with the same CLISource"
+
+func (this *CLISource) parsePositionals() error {
+	if len(this.positionals) == 0 {
+		return nil
+	}
+
+	remaining := this.arguments
+	for _, positional := range this.positionals {
+		positional.values = nil
+
+		if len(remaining) == 0 {
+			if positional.required {
+				return this.fail(fmt.Errorf("missing required argument: %s", positional.name))
+			}
+			continue
+		}
+
+		if positional.variadic {
+			positional.values, remaining = remaining, nil
+		} else {
+			positional.values, remaining = remaining[:1], remaining[1:]
+		}
+	}
+
+	if len(remaining) > 0 {
+		return this.fail(fmt.Errorf("too many arguments: %s", strings.Join(remaining, " ")))
+	}
+	return nil
+}
+
+func (this *CLISource) printPositionals() {
+	if len(this.positionals) == 0 {
+		return
+	}
+
+	fmt.Fprintln(this.out(), "Arguments:")
+	for _, positional := range this.positionals {
+		name := positional.name
+		if positional.variadic {
+			name += "..."
+		}
+		if positional.required {
+			fmt.Fprintf(this.out(), "  %s (required)\n    \t%s\n", name, positional.description)
+		} else {
+			fmt.Fprintf(this.out(), "  %s\n    \t%s\n", name, positional.description)
+		}
+	}
+}
@@ -0,0 +1,96 @@
+package configo
+
+import (
+	"fmt"
+	"os"
+	"strings"
+)
+
+// Command registers a subcommand (ex: "serve" in "./app -verbose serve -listen :80") with its own
+// flags, usage text, and (optionally) nested subcommands, all of which are configured by the options.
+// Flags supplied before the subcommand name belong to the parent, those after it to the subcommand.
+// Only the flags of the selected subcommand (and its parents) are provided to the Reader.
+// Subcommands can't be registered alongside positional arguments (see Argument).
+func Command(name, description string, options ...CLI) CLI {
+	return func(this *CLISource) {
+		if len(this.positionals) > 0 {
+			panic(exclusiveArgumentsAndCommands)
+		}
+		command := FromCLI(options...)
+		command.name = name
+		command.description = description
+		command.parent = this
+		this.commands[name] = command
+	}
+}
+
+// CommandPath returns the names of the subcommands selected on the command line, outermost first.
+// It is empty if no subcommand was selected.
+func (this *CLISource) CommandPath() (path []string) {
+	for command := this.selected; command != nil; command = command.selected {
+		path = append(path, command.name)
+	}
+	return path
+}
+
+func (this *CLISource) parseCommand() error {
+	if len(this.commands) == 0 || len(this.arguments) == 0 {
+		return nil
+	}
+
+	command, found := this.commands[this.arguments[0]]
+	if !found {
+		return this.fail(fmt.Errorf("unknown command: %s", this.arguments[0]))
+	}
+
+	this.selected = command
+	command.inherit(this)
+	return command.parse(this.arguments[1:])
+}
+
+// inherit applies the parsing and output behavior of the parent to the subcommand.
+func (this *CLISource) inherit(parent *CLISource) {
+	this.source = parent.source
+	this.gnu = this.gnu || parent.gnu
+	if this.output == nil {
+		this.output = parent.output
+	}
+	this.flags.Init(this.program(), parent.flags.ErrorHandling())
+	this.flags.SetOutput(this.out())
+}
+
+// gnuStyle reports whether GNUStyle applies to the CLISource, either directly or through a parent
+// (see inherit), regardless of whether the command line has been parsed.
+func (this *CLISource) gnuStyle() bool {
+	for source := this; source != nil; source = source.parent {
+		if source.gnu {
+			return true
+		}
+	}
+	return false
+}
+
+func (this *CLISource) program() string {
+	if this.parent == nil {
+		return os.Args[0]
+	}
+	return this.parent.program() + " " + this.name
+}
+
+func (this *CLISource) printCommands() {
+	if len(this.commands) == 0 {
+		return
+	}
+
+	width := 0
+	for name := range this.commands {
+		if len(name) > width {
+			width = len(name)
+		}
+	}
+
+	fmt.Fprintln(this.out(), "Commands:")
+	for _, name := range this.commandNames() {
+		fmt.Fprintf(this.out(), "  %s%s  %s\n", name, strings.Repeat(" ", width-len(name)), this.commands[name].description)
+	}
+}
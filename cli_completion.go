@@ -0,0 +1,278 @@
+package configo
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+var errUnsupportedShell = errors.New("unsupported shell (expected bash, zsh, or fish)")
+
+// Completion generates a completion script for the given shell ("bash", "zsh", or "fish") from the
+// flags and subcommands registered with the CLISource. The program is the name of the executable
+// being completed (the base name of os.Args[0] if empty). The values of flags registered with
+// CompleteFiles or CompleteValues are completed accordingly.
+func (this *CLISource) Completion(shell, program string) (string, error) {
+	if len(program) == 0 {
+		program = filepath.Base(os.Args[0])
+	}
+
+	builder := &completionBuilder{program: program, function: "_" + sanitizeKey(program) + "_completion"}
+	this.completionLevels("", this.gnu, &builder.levels)
+
+	switch shell {
+	case "bash":
+		return builder.bash(), nil
+	case "zsh":
+		return builder.zsh(), nil
+	case "fish":
+		return builder.fish(), nil
+	default:
+		return "", errUnsupportedShell
+	}
+}
+
+// completionLevel is a CLISource (the root or a subcommand) identified by its
+// path of subcommand names (ex: "/serve/tls"; the root is ""). Because subcommands
+// only inherit GNUStyle from their parents as the command line is parsed, gnu
+// records whether GNUStyle applies to the level or any of its parents.
+type completionLevel struct {
+	path   string
+	source *CLISource
+	gnu    bool
+}
+
+func (this *CLISource) completionLevels(path string, gnu bool, levels *[]completionLevel) {
+	gnu = gnu || this.gnu
+	*levels = append(*levels, completionLevel{path: path, source: this, gnu: gnu})
+	for _, name := range this.commandNames() {
+		this.commands[name].completionLevels(path+"/"+name, gnu, levels)
+	}
+}
+
+func (this *CLISource) commandNames() []string {
+	names := make([]string, 0, len(this.commands))
+	for name := range this.commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (this *CLISource) flagNames() []string {
+	names := make([]string, 0, len(this.registry))
+	for name := range this.registry {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// flagWords returns the words (ex: "-verbose" and "-v", or "--verbose" when using GNUStyle)
+// which identify the flag on the command line.
+func (this completionLevel) flagWords(definition *cliFlag) (words []string) {
+	if this.gnu {
+		words = append(words, "--"+definition.name)
+	} else {
+		words = append(words, "-"+definition.name)
+	}
+	if definition.short != 0 {
+		words = append(words, "-"+string(definition.short))
+	}
+	return words
+}
+
+/* ////////////////////////////////////////////////////////////////////////////////////////////////////////////////// */
+
+type completionBuilder struct {
+	program  string
+	function string
+	levels   []completionLevel
+	script   strings.Builder
+}
+
+func (this *completionBuilder) printf(format string, args ...interface{}) {
+	fmt.Fprintf(&this.script, format, args...)
+}
+
+func (this *completionBuilder) commandPaths() (paths []string) {
+	for _, level := range this.levels[1:] {
+		paths = append(paths, `"`+level.path+`"`)
+	}
+	return paths
+}
+
+func (this *completionBuilder) words(level completionLevel) (words []string) {
+	for _, name := range level.source.flagNames() {
+		words = append(words, level.flagWords(level.source.registry[name])...)
+	}
+	return append(words, level.source.commandNames()...)
+}
+
+// valueCases calls the callback for each flag word (of each level) which expects a value.
+func (this *completionBuilder) valueCases(callback func(path, word string, definition *cliFlag)) {
+	for _, level := range this.levels {
+		for _, name := range level.source.flagNames() {
+			definition := level.source.registry[name]
+			if definition.IsBoolFlag() {
+				continue
+			}
+			for _, word := range level.flagWords(definition) {
+				callback(level.path, word, definition)
+			}
+		}
+	}
+}
+
+func (this *completionBuilder) bash() string {
+	this.printf("# bash completion for %s\n", this.program)
+	this.printf("%s() {\n", this.function)
+	this.printf("    local cur=\"${COMP_WORDS[COMP_CWORD]}\" prev=\"${COMP_WORDS[COMP_CWORD-1]}\" command_path=\"\" word i\n")
+	this.printf("    for ((i = 1; i < COMP_CWORD; i++)); do\n")
+	this.printf("        word=\"${COMP_WORDS[i]}\"\n")
+	if paths := this.commandPaths(); len(paths) > 0 {
+		this.printf("        case \"${command_path}/${word}\" in\n")
+		this.printf("            %s) command_path=\"${command_path}/${word}\" ;;\n", strings.Join(paths, "|"))
+		this.printf("        esac\n")
+	}
+	this.printf("    done\n")
+	this.printf("    case \"${command_path}:${prev}\" in\n")
+	this.valueCases(func(path, word string, definition *cliFlag) {
+		if definition.files {
+			this.printf("        \"%s:%s\") COMPREPLY=($(compgen -f -- \"$cur\")); return ;;\n", path, word)
+		} else if len(definition.completions) > 0 {
+			this.printf("        \"%s:%s\") for word in %s; do [[ \"$word\" == \"$cur\"* ]] && COMPREPLY+=(\"$word\"); done; return ;;\n", path, word, shellQuote(definition.completions))
+		} else {
+			this.printf("        \"%s:%s\") return ;;\n", path, word)
+		}
+	})
+	this.printf("    esac\n")
+	this.printf("    case \"$command_path\" in\n")
+	for _, level := range this.levels {
+		this.printf("        \"%s\") COMPREPLY=($(compgen -W \"%s\" -- \"$cur\")) ;;\n", level.path, strings.Join(this.words(level), " "))
+	}
+	this.printf("    esac\n")
+	this.printf("}\n")
+	this.printf("complete -F %s %s\n", this.function, this.program)
+	return this.script.String()
+}
+
+func (this *completionBuilder) zsh() string {
+	this.printf("#compdef %s\n", this.program)
+	this.printf("%s() {\n", this.function)
+	this.printf("    local prev=\"${words[CURRENT-1]}\" command_path=\"\" word i\n")
+	this.printf("    for ((i = 2; i < CURRENT; i++)); do\n")
+	this.printf("        word=\"${words[i]}\"\n")
+	if paths := this.commandPaths(); len(paths) > 0 {
+		this.printf("        case \"${command_path}/${word}\" in\n")
+		this.printf("            %s) command_path=\"${command_path}/${word}\" ;;\n", strings.Join(paths, "|"))
+		this.printf("        esac\n")
+	}
+	this.printf("    done\n")
+	this.printf("    case \"${command_path}:${prev}\" in\n")
+	this.valueCases(func(path, word string, definition *cliFlag) {
+		if definition.files {
+			this.printf("        \"%s:%s\") _files; return ;;\n", path, word)
+		} else if len(definition.completions) > 0 {
+			this.printf("        \"%s:%s\") compadd -- %s; return ;;\n", path, word, shellQuote(definition.completions))
+		} else {
+			this.printf("        \"%s:%s\") return ;;\n", path, word)
+		}
+	})
+	this.printf("    esac\n")
+	this.printf("    case \"$command_path\" in\n")
+	for _, level := range this.levels {
+		this.printf("        \"%s\") compadd -- %s ;;\n", level.path, strings.Join(this.words(level), " "))
+	}
+	this.printf("    esac\n")
+	this.printf("}\n")
+	this.printf("compdef %s %s\n", this.function, this.program)
+	return this.script.String()
+}
+
+func (this *completionBuilder) fish() string {
+	function := "__" + sanitizeKey(this.program) + "_command_path"
+
+	this.printf("# fish completion for %s\n", this.program)
+	this.printf("function %s\n", function)
+	this.printf("    set -l command_path \"\"\n")
+	this.printf("    for word in (commandline -opc)[2..-1]\n")
+	if paths := this.commandPaths(); len(paths) > 0 {
+		this.printf("        switch \"$command_path/$word\"\n")
+		this.printf("            case %s\n", strings.Join(paths, " "))
+		this.printf("                set command_path \"$command_path/$word\"\n")
+		this.printf("        end\n")
+	}
+	this.printf("    end\n")
+	this.printf("    echo $command_path\n")
+	this.printf("end\n")
+	this.printf("complete -c %s -f\n", this.program)
+
+	for _, level := range this.levels {
+		condition := fmt.Sprintf("-n 'test \"(%s)\" = \"%s\"'", function, level.path)
+
+		for _, name := range level.source.commandNames() {
+			this.printf("complete -c %s %s -a %s -d %s\n", this.program, condition, name, fishQuote(level.source.commands[name].description))
+		}
+
+		for _, name := range level.source.flagNames() {
+			definition := level.source.registry[name]
+			this.printf("complete -c %s %s %s", this.program, condition, this.fishFlag(level, definition))
+			if definition.files {
+				this.printf(" -r -F")
+			} else if len(definition.completions) > 0 {
+				this.printf(" -r -a %s", fishQuote(fishEscape(definition.completions)))
+			} else if !definition.IsBoolFlag() {
+				this.printf(" -r")
+			}
+			this.printf(" -d %s\n", fishQuote(definition.description))
+		}
+	}
+	return this.script.String()
+}
+
+func (this *completionBuilder) fishFlag(level completionLevel, definition *cliFlag) string {
+	option := "-o " + definition.name
+	if level.gnu {
+		option = "-l " + definition.name
+	}
+	if definition.short != 0 {
+		option += " -s " + string(definition.short)
+	}
+	return option
+}
+
+func fishQuote(value string) string {
+	return "'" + strings.Replace(strings.Replace(value, `\`, `\\`, -1), "'", `\'`, -1) + "'"
+}
+
+// shellQuote single-quotes each of the words (for bash or zsh) so that they're supplied verbatim.
+func shellQuote(words []string) string {
+	quoted := make([]string, len(words))
+	for i, word := range words {
+		quoted[i] = "'" + strings.Replace(word, "'", `'\''`, -1) + "'"
+	}
+	return strings.Join(quoted, " ")
+}
+
+// fishEscape escapes the characters of each of the words which fish would otherwise interpret when
+// it expands the arguments of complete -a, and joins the words with spaces.
+func fishEscape(words []string) string {
+	escaped := make([]string, len(words))
+	for i, word := range words {
+		var builder strings.Builder
+		for _, character := range word {
+			if character < 0x80 && !strings.ContainsRune(fishVerbatim, character) {
+				builder.WriteByte('\\')
+			}
+			builder.WriteRune(character)
+		}
+		escaped[i] = builder.String()
+	}
+	return strings.Join(escaped, " ")
+}
+
+const fishVerbatim = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789_-+=.,:/@"
@@ -1,44 +1,133 @@
 package configo
 
-// CLIConfigFileSource registers a command line flag for specifying an optional json config file,
+import (
+	"errors"
+	"strings"
+)
+
+// CLIConfigFileSource registers a command line flag for specifying optional json config files,
 // beyond any other config file definitions that follow this one. It is intended to be used to provide an
 // override to the regularly used config file(s), like when you might be debugging in production (admit it,
-// you've been there too).
+// you've been there too). The flag may be repeated (or its value comma-separated) to stack several override
+// files, in which case the values of the last file take precedence. Because every value is split on commas,
+// the path of an override file can't contain a comma. Unless StrictConfigFiles is specified, any file which
+// is missing or empty is skipped (see Applied), but a file which isn't valid JSON still results in a panic.
 type CLIConfigFileSource struct {
 	flagName    string
 	commandLine *CLISource
-	json        Source
+	strict      bool
+	json        []Source
+	applied     []string
+}
+
+// ConfigFileOption configures a CLIConfigFileSource.
+type ConfigFileOption func(*CLIConfigFileSource)
+
+// StrictConfigFiles causes Initialize to panic if any config file specified on the command line
+// does not exist or cannot be parsed (rather than silently ignoring it).
+func StrictConfigFiles() ConfigFileOption {
+	return func(this *CLIConfigFileSource) { this.strict = true }
 }
 
 // FromDefaultCLIConfigFileSource registers a command line flag called "config" for specifying
 // an alternate JSON config file.
-func FromDefaultCLIConfigFileSource() *CLIConfigFileSource {
-	return FromCLIConfigFileSource("config")
+func FromDefaultCLIConfigFileSource(options ...ConfigFileOption) *CLIConfigFileSource {
+	return FromCLIConfigFileSource("config", options...)
 }
 
 // FromCLIConfigFileSource registers a command line flag with the given flagName for specifying
 // an alternate JSON config file.
-func FromCLIConfigFileSource(flagName string) *CLIConfigFileSource {
-	return &CLIConfigFileSource{
-		flagName:    flagName,
-		commandLine: FromCLI(Flag(flagName, "The default configuration file path.")),
+func FromCLIConfigFileSource(flagName string, options ...ConfigFileOption) *CLIConfigFileSource {
+	return newCLIConfigFileSource(flagName, FromCLI(MultiFlag(flagName, "The default configuration file path.")), options)
+}
+
+// FromCLISourceConfigFile registers a command line flag with the given flagName on the provided CLISource
+// (unless it is already registered) for specifying an alternate JSON config file. Unlike FromCLIConfigFileSource,
+// the command line is parsed once by the shared CLISource, so the two sources can't disagree about it.
+func FromCLISourceConfigFile(commandLine *CLISource, flagName string, options ...ConfigFileOption) *CLIConfigFileSource {
+	if _, found := commandLine.registry[flagName]; !found {
+		MultiFlag(flagName, "The default configuration file path.")(commandLine)
 	}
+	return newCLIConfigFileSource(flagName, commandLine, options)
 }
 
-// Initialize parses the command line flag and reads the altnerate JSON source.
+func newCLIConfigFileSource(flagName string, commandLine *CLISource, options []ConfigFileOption) *CLIConfigFileSource {
+	source := &CLIConfigFileSource{flagName: flagName, commandLine: commandLine}
+	for _, option := range options {
+		option(source)
+	}
+	return source
+}
+
+// Initialize parses the command line flag and reads the alternate JSON source(s).
 func (this *CLIConfigFileSource) Initialize() {
 	this.commandLine.Initialize()
+	this.json, this.applied = nil, nil
+
+	paths, _ := this.commandLine.Strings(this.flagName)
+	for _, path := range splitConfigFilePaths(paths) {
+		var json *JSONSource
+		if this.strict {
+			json = FromJSONFile(path)
+		} else {
+			json = FromOptionalJSONFile(path)
+		}
 
-	path, err := this.commandLine.Strings(this.flagName)
-	if err == nil && len(path) > 0 {
-		this.json = FromOptionalJSONFile(path[0])
+		if json != nil {
+			this.json = append(this.json, json)
+			this.applied = append(this.applied, path)
+		}
 	}
 }
+func splitConfigFilePaths(values []string) (paths []string) {
+	for _, value := range values {
+		for _, path := range strings.Split(value, ",") {
+			if path = strings.TrimSpace(path); len(path) > 0 {
+				paths = append(paths, path)
+			}
+		}
+	}
+	return paths
+}
 
-// Strings reads the key from the JSON source if it was successfully loaded during Initialize.
+// Applied returns the paths of the config files (in the order specified) which were
+// successfully loaded during Initialize.
+func (this *CLIConfigFileSource) Applied() []string {
+	return this.applied
+}
+
+// Strings reads the key from the JSON source(s) successfully loaded during Initialize,
+// beginning with the last one specified.
 func (this *CLIConfigFileSource) Strings(key string) ([]string, error) {
-	if this.json == nil {
-		return nil, ErrKeyNotFound
+	for i := len(this.json) - 1; i >= 0; i-- {
+		if values, err := this.json[i].Strings(key); err == nil {
+			return values, nil
+		}
+	}
+	return nil, ErrKeyNotFound
+}
+
+// Filename returns the path of the last JSON file (see Strings) which provides the key (see FileSource).
+func (this *CLIConfigFileSource) Filename(key string) string {
+	for i := len(this.json) - 1; i >= 0; i-- {
+		if file, ok := this.json[i].(FileSource); ok {
+			if filename := file.Filename(key); len(filename) > 0 {
+				return filename
+			}
+		}
+	}
+	return ""
+}
+
+// StringMap reads the nested object (see MapSource) from the JSON source(s) successfully loaded
+// during Initialize, beginning with the last one specified.
+func (this *CLIConfigFileSource) StringMap(key string) (map[string]string, error) {
+	for i := len(this.json) - 1; i >= 0; i-- {
+		if hierarchical, ok := this.json[i].(MapSource); ok {
+			if values, err := hierarchical.StringMap(key); !errors.Is(err, ErrKeyNotFound) {
+				return values, err
+			}
+		}
 	}
-	return this.json.Strings(key)
+	return nil, ErrKeyNotFound
 }
@@ -0,0 +1,90 @@
+package configo
+
+import (
+	"testing"
+
+	"github.com/smartystreets/assertions/should"
+	"github.com/smartystreets/gunit"
+)
+
+func TestCLIConfigFileSourceFixture(t *testing.T) {
+	gunit.Run(new(CLIConfigFileSourceFixture), t)
+}
+
+type CLIConfigFileSourceFixture struct {
+	*gunit.Fixture
+
+	directory tempDirectory
+}
+
+func (this *CLIConfigFileSourceFixture) Setup() {
+	this.directory = newTempDirectory("cliConfigFile")
+}
+
+func (this *CLIConfigFileSourceFixture) Teardown() {
+	this.directory.remove()
+}
+
+func (this *CLIConfigFileSourceFixture) TestStackedFilesLastWins() {
+	first := this.write("first.json", `{"a":"first","b":"first"}`)
+	second := this.write("second.json", `{"b":"second","c":"second"}`)
+	third := this.write("third.json", `{"c":"third"}`)
+	missing := this.directory.path("missing.json")
+
+	source := this.initialize("-config", first+","+missing, "-config="+second, "-config", third)
+
+	this.So(source.Applied(), should.Resemble, []string{first, second, third})
+	this.assertValue(source, "a", "first")
+	this.assertValue(source, "b", "second")
+	this.assertValue(source, "c", "third")
+	_, err := source.Strings("d")
+	this.So(err, should.Equal, ErrKeyNotFound)
+}
+
+func (this *CLIConfigFileSourceFixture) TestNoFilesSpecified() {
+	source := this.initialize()
+
+	this.So(source.Applied(), should.BeEmpty)
+	_, err := source.Strings("a")
+	this.So(err, should.Equal, ErrKeyNotFound)
+}
+
+func (this *CLIConfigFileSourceFixture) TestStrictModeFailsOnMissingOrMalformedFiles() {
+	missing := this.directory.path("missing.json")
+	malformed := this.write("malformed.json", `{"a":`)
+
+	for _, filename := range []string{missing, malformed} {
+		commandLine := FromCLIArguments([]string{"-config", filename})
+		source := FromCLISourceConfigFile(commandLine, "config", StrictConfigFiles())
+
+		this.So(source.Initialize, should.Panic)
+	}
+}
+
+func (this *CLIConfigFileSourceFixture) TestLenientModeSkipsOnlyMissingOrEmptyFiles() {
+	valid := this.write("valid.json", `{"a":"valid"}`)
+	empty := this.write("empty.json", ``)
+	malformed := this.write("malformed.json", `{"a":`)
+
+	source := this.initialize("-config", valid, "-config", empty)
+	this.So(source.Applied(), should.Resemble, []string{valid})
+	this.assertValue(source, "a", "valid")
+
+	this.So(func() { this.initialize("-config", valid, "-config", malformed) }, should.Panic)
+}
+
+func (this *CLIConfigFileSourceFixture) initialize(arguments ...string) *CLIConfigFileSource {
+	source := FromCLISourceConfigFile(FromCLIArguments(arguments), "config")
+	source.Initialize()
+	return source
+}
+
+func (this *CLIConfigFileSourceFixture) assertValue(source Source, key, expected string) {
+	values, err := source.Strings(key)
+	this.So(err, should.BeNil)
+	this.So(values, should.Resemble, []string{expected})
+}
+
+func (this *CLIConfigFileSourceFixture) write(name, contents string) string {
+	return this.directory.write(name, []byte(contents))
+}
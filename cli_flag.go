@@ -0,0 +1,136 @@
+package configo
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// cliFlag is the flag.Value registered for each of the flags of a CLISource.
+// It validates each value (according to its kind) as the command line is parsed.
+type cliFlag struct {
+	name            string
+	description     string
+	kind            flagKind
+	format          string
+	multi           bool
+	short           rune
+	required        bool
+	environment     string
+	fromEnvironment bool // whether the values were supplied by the environment variable (see Env)
+	separator       string
+	files           bool
+	completions     []string
+	values          []string
+}
+
+type flagKind int
+
+const (
+	stringKind flagKind = iota
+	boolKind
+	intKind
+	floatKind
+	durationKind
+	urlKind
+	timeKind
+)
+
+func (this *cliFlag) Set(raw string) error {
+	value, err := this.parse(raw)
+	if err != nil {
+		return err
+	}
+
+	if this.multi {
+		this.values = append(this.values, value)
+	} else {
+		this.values = []string{value}
+	}
+	return nil
+}
+
+func (this *cliFlag) parse(raw string) (string, error) {
+	var err error
+	switch this.kind {
+	case boolKind:
+		var value bool
+		if value, err = strconv.ParseBool(raw); err == nil {
+			return strconv.FormatBool(value), nil
+		}
+	case intKind:
+		_, err = strconv.Atoi(raw)
+	case floatKind:
+		_, err = strconv.ParseFloat(raw, 64)
+	case durationKind:
+		_, err = time.ParseDuration(raw)
+	case urlKind:
+		_, err = url.Parse(raw)
+	case timeKind:
+		_, err = time.Parse(this.format, raw)
+	}
+	return raw, err
+}
+
+func (this *cliFlag) String() string {
+	if this == nil {
+		return ""
+	}
+	return strings.Join(this.values, ",")
+}
+
+// IsBoolFlag allows boolean flags to be supplied without a value (ex: -verbose).
+func (this *cliFlag) IsBoolFlag() bool {
+	return this.kind == boolKind
+}
+
+// placeholder names the kind of value expected by the flag (for use in usage text).
+func (this *cliFlag) placeholder() string {
+	switch this.kind {
+	case boolKind:
+		return "bool"
+	case intKind:
+		return "int"
+	case floatKind:
+		return "float"
+	case durationKind:
+		return "duration"
+	case urlKind:
+		return "url"
+	case timeKind:
+		return "time"
+	default:
+		return "value"
+	}
+}
+
+// signature is the first line of the usage text of the flag: its names (including the short alias)
+// as they're supplied on the command line (according to GNUStyle) followed by the placeholder.
+func (this *cliFlag) signature(gnu bool) string {
+	var line string
+	switch {
+	case gnu && this.short != 0:
+		line = fmt.Sprintf("  -%c, --%s", this.short, this.name)
+	case gnu:
+		line = "      --" + this.name
+	case this.short != 0:
+		line = fmt.Sprintf("  -%c, -%s", this.short, this.name)
+	default:
+		line = "  -" + this.name
+	}
+
+	if !this.IsBoolFlag() {
+		line += " " + this.placeholder()
+	}
+	return line
+}
+
+// usage is the description of the flag along with the environment variable bound to it (if any).
+func (this *cliFlag) usage() string {
+	if len(this.environment) == 0 {
+		return this.description
+	}
+	return this.description + " [$" + this.environment + "]"
+}
@@ -0,0 +1,83 @@
+package configo
+
+import (
+	"fmt"
+	"strings"
+)
+
+// PrintHelp prints each registered flag (including those of the selected subcommand) along with
+// its effective value according to the provided Reader, the source which provided that value, the
+// name of the environment variable bound to the flag (see Env) or that an EnvironmentSource of the
+// Reader would read, and whether the flag is required, followed by the positional arguments and
+// the available subcommands. Unlike the usage printed by the -help flag, it reflects values
+// supplied by every source, such as a DefaultSource or JSON file.
+func (this *CLISource) PrintHelp(reader *Reader) {
+	fmt.Fprintf(this.out(), "Usage of %s:\n", this.program())
+	for command := this; command != nil; command = command.selected {
+		command.printEffectiveFlags(reader)
+		command.printPositionals()
+		if command.selected == nil {
+			command.printCommands()
+		}
+	}
+	fmt.Fprintln(this.out(), this.usageMessage)
+}
+
+func (this *CLISource) printEffectiveFlags(reader *Reader) {
+	for _, name := range this.flagNames() {
+		definition := this.registry[name]
+		values, source, err := reader.locate(name)
+
+		line := definition.signature(this.gnuStyle())
+		if definition.required && err != nil {
+			line += " (required, missing)"
+		} else if definition.required {
+			line += " (required)"
+		}
+		fmt.Fprintf(this.out(), "%s\n    \t%s\n", line, definition.description)
+
+		if err != nil {
+			fmt.Fprintln(this.out(), "    \tvalue: (not set)")
+		} else if definition.fromEnvironment && this.includes(source) {
+			fmt.Fprintf(this.out(), "    \tvalue: %q (from environment %s)\n", strings.Join(values, ","), definition.environment)
+		} else {
+			fmt.Fprintf(this.out(), "    \tvalue: %q (from %s)\n", strings.Join(values, ","), reader.sourceName(source, name))
+		}
+
+		if variable := definition.environment; len(variable) > 0 {
+			fmt.Fprintf(this.out(), "    \tenvironment: %s\n", variable)
+		} else if variable := reader.environmentVariable(name); len(variable) > 0 {
+			fmt.Fprintf(this.out(), "    \tenvironment: %s\n", variable)
+		}
+	}
+}
+
+// includes reports whether the source is the CLISource or one of its parents, which provide the
+// values of the flags of the CLISource (see Strings).
+func (this *CLISource) includes(source Source) bool {
+	for command := this; command != nil; command = command.parent {
+		if source == Source(command) {
+			return true
+		}
+	}
+	return false
+}
+
+// environmentVariable returns the name of the environment variable most likely to supply
+// the key to the first EnvironmentSource of the Reader (if any).
+func (this *Reader) environmentVariable(key string) string {
+	return environmentVariable(this.sources, key)
+}
+func environmentVariable(sources []Source, key string) string {
+	for _, source := range sources {
+		switch typed := source.(type) {
+		case *EnvironmentSource:
+			return typed.variableName(key)
+		case MultiSource:
+			if variable := environmentVariable(typed, key); len(variable) > 0 {
+				return variable
+			}
+		}
+	}
+	return ""
+}
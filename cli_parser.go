@@ -0,0 +1,161 @@
+package configo
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// parseGNU parses the arguments according to GNU conventions (see GNUStyle), honoring
+// the flag.ErrorHandling mode of the internal *flag.FlagSet.
+func (this *CLISource) parseGNU(arguments []string) error {
+	this.arguments = nil
+
+	if err := this.parseGNUArguments(arguments); err != nil {
+		return this.fail(err)
+	}
+	return nil
+}
+
+// fail reports the error (and usage) in the same way as the internal *flag.FlagSet would,
+// according to its flag.ErrorHandling mode.
+func (this *CLISource) fail(err error) error {
+	if err != flag.ErrHelp {
+		fmt.Fprintln(this.out(), err)
+	}
+	this.flags.Usage()
+
+	switch this.flags.ErrorHandling() {
+	case flag.ExitOnError:
+		if err == flag.ErrHelp {
+			os.Exit(0)
+		}
+		os.Exit(2)
+	case flag.PanicOnError:
+		panic(err)
+	}
+	return err
+}
+
+func (this *CLISource) parseGNUArguments(arguments []string) error {
+	for i := 0; i < len(arguments); i++ {
+		argument := arguments[i]
+
+		switch {
+		case argument == "--":
+			this.arguments = append(this.arguments, arguments[i+1:]...)
+			return nil
+		case strings.HasPrefix(argument, "--"):
+			consumed, err := this.parseLongOption(argument[2:], arguments[i+1:])
+			if err != nil {
+				return err
+			}
+			i += consumed
+		case strings.HasPrefix(argument, "-") && len(argument) > 1:
+			consumed, err := this.parseShortOptions(argument[1:], arguments[i+1:])
+			if err != nil {
+				return err
+			}
+			i += consumed
+		case len(this.commands) > 0:
+			this.arguments = append(this.arguments, arguments[i:]...)
+			return nil
+		default:
+			this.arguments = append(this.arguments, argument)
+		}
+	}
+	return nil
+}
+
+// parseLongOption parses --name, --name=value, or --name value (in which case
+// the value is consumed from the remaining arguments).
+func (this *CLISource) parseLongOption(option string, remaining []string) (consumed int, err error) {
+	name, value, hasValue := option, "", false
+	if equals := strings.Index(option, "="); equals >= 0 {
+		name, value, hasValue = option[:equals], option[equals+1:], true
+	}
+
+	definition, found := this.registry[name]
+	if !found && (name == "help" || name == "h") {
+		return 0, flag.ErrHelp
+	} else if !found {
+		return 0, fmt.Errorf("unknown flag: --%s", name)
+	}
+
+	if !hasValue && definition.IsBoolFlag() {
+		value, hasValue = "true", true
+	}
+	if !hasValue {
+		if len(remaining) == 0 {
+			return 0, fmt.Errorf("flag needs an argument: --%s", name)
+		}
+		value, consumed = remaining[0], 1
+	}
+
+	return consumed, setGNUValue(definition, "--"+name, value)
+}
+
+// parseShortOptions parses one or more short aliases (ex: -v, -v=false, -abc, -p 8080, -p8080, or -p=8080).
+func (this *CLISource) parseShortOptions(options string, remaining []string) (consumed int, err error) {
+	shorts := this.shortAliases()
+
+	for i, alias := range options {
+		definition, found := shorts[alias]
+		if !found && alias == 'h' {
+			return 0, flag.ErrHelp
+		} else if !found && len(options) > 1 {
+			return 0, fmt.Errorf("unknown shorthand flag: '%c' in -%s", alias, options)
+		} else if !found {
+			return 0, fmt.Errorf("unknown shorthand flag: '%c'", alias)
+		}
+
+		name := "-" + string(alias)
+		remainder := options[i+len(string(alias)):]
+		if definition.IsBoolFlag() && strings.HasPrefix(remainder, "=") {
+			return 0, setGNUValue(definition, name, remainder[1:])
+		} else if definition.IsBoolFlag() {
+			if err := setGNUValue(definition, name, "true"); err != nil {
+				return 0, err
+			}
+			continue
+		}
+
+		value := strings.TrimPrefix(remainder, "=")
+		if len(value) == 0 {
+			if len(remaining) == 0 {
+				return 0, fmt.Errorf("flag needs an argument: '%c' in -%s", alias, options)
+			}
+			value, consumed = remaining[0], 1
+		}
+		return consumed, setGNUValue(definition, name, value)
+	}
+
+	return 0, nil
+}
+
+func (this *CLISource) shortAliases() map[rune]*cliFlag {
+	shorts := make(map[rune]*cliFlag, len(this.registry))
+	for _, definition := range this.registry {
+		if definition.short != 0 {
+			shorts[definition.short] = definition
+		}
+	}
+	return shorts
+}
+
+func setGNUValue(definition *cliFlag, name, value string) error {
+	if err := definition.Set(value); err != nil {
+		return fmt.Errorf("invalid value %q for flag %s: %s", value, name, err)
+	}
+	return nil
+}
+
+// printDefaults prints each flag (with its short alias, if any) and its description. Unlike
+// flag.PrintDefaults, a short alias isn't listed as a separate flag.
+func (this *CLISource) printDefaults() {
+	for _, name := range this.flagNames() {
+		definition := this.registry[name]
+		fmt.Fprintf(this.out(), "%s\n    \t%s\n", definition.signature(this.gnuStyle()), definition.usage())
+	}
+}
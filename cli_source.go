@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"io"
 	"os"
-	"strconv"
 )
 
 // CLISource allows for registration of command line flags
@@ -14,11 +13,20 @@ import (
 type CLISource struct {
 	source       []string
 	flags        *flag.FlagSet
-	registry     map[string]*string
-	boolRegistry map[string]*bool
-	values       map[string]string
+	registry     map[string]*cliFlag
+	values       map[string][]string
 	output       io.Writer
 	usageMessage string
+	gnu          bool
+	arguments    []string
+	name         string
+	description  string
+	parent       *CLISource
+	commands     map[string]*CLISource
+	selected     *CLISource
+	positionals  []*cliArgument
+	parsed       bool
+	err          error
 }
 
 const flagSetName = "configo"
@@ -28,18 +36,28 @@ const flagSetName = "configo"
 // Be default the flag.ErrorHandling mode is set to flag.ExitOnError
 func FromCLI(options ...CLI) *CLISource {
 	source := &CLISource{
-		source:       os.Args,
-		flags:        flag.NewFlagSet(flagSetName, flag.ExitOnError),
-		registry:     make(map[string]*string),
-		boolRegistry: make(map[string]*bool),
-		values:       make(map[string]string),
+		source:   os.Args,
+		flags:    flag.NewFlagSet(flagSetName, flag.ExitOnError),
+		registry: make(map[string]*cliFlag),
+		values:   make(map[string][]string),
+		commands: make(map[string]*CLISource),
 	}
+	source.flags.Usage = source.usage
 	for _, option := range options {
 		option(source)
 	}
 	return source
 }
 
+// FromCLIArguments is like FromCLI but parses the provided arguments (which should not include
+// the program name) rather than os.Args. The flag.ErrorHandling mode defaults to flag.ContinueOnError
+// so that any error is returned by Parse (rather than exiting the process).
+func FromCLIArguments(arguments []string, options ...CLI) *CLISource {
+	source := FromCLI(append([]CLI{ContinueOnError()}, options...)...)
+	source.source = append([]string{os.Args[0]}, arguments...)
+	return source
+}
+
 type CLI func(*CLISource)
 
 // ContinueOnError sets the flag.ErrorHandling mode of the internal *flag.FlagSet
@@ -55,15 +73,117 @@ func PanicOnError() CLI {
 }
 
 // Flag registers a flag and corresponding usage description with the CLISource.
-func Flag(name, description string) CLI {
-	return func(this *CLISource) { this.registry[name] = this.flags.String(name, "", description) }
+func Flag(name, description string, options ...FlagOption) CLI {
+	return registerFlag(cliFlag{name: name, description: description, kind: stringKind}, options)
 }
 
 // BoolFlag registers a boolean flag and corresponding usage description with the CLISource.
 // The advantage of this method over Flag for boolean values is that the user can merely
 // supply the flag without a value to set the boolean flag to true. This doesn't work with Flag.
-func BoolFlag(name, description string) CLI {
-	return func(this *CLISource) { this.boolRegistry[name] = this.flags.Bool(name, false, description) }
+func BoolFlag(name, description string, options ...FlagOption) CLI {
+	return registerFlag(cliFlag{name: name, description: description, kind: boolKind}, options)
+}
+
+// IntFlag registers a flag whose value must be parsable as an integer (according to strconv.Atoi).
+// Malformed values are reported when the command line is parsed.
+func IntFlag(name, description string, options ...FlagOption) CLI {
+	return registerFlag(cliFlag{name: name, description: description, kind: intKind}, options)
+}
+
+// FloatFlag registers a flag whose value must be parsable as a float64 (according to strconv.ParseFloat).
+// Malformed values are reported when the command line is parsed.
+func FloatFlag(name, description string, options ...FlagOption) CLI {
+	return registerFlag(cliFlag{name: name, description: description, kind: floatKind}, options)
+}
+
+// DurationFlag registers a flag whose value must be parsable by time.ParseDuration.
+// Malformed values are reported when the command line is parsed.
+func DurationFlag(name, description string, options ...FlagOption) CLI {
+	return registerFlag(cliFlag{name: name, description: description, kind: durationKind}, options)
+}
+
+// URLFlag registers a flag whose value must be parsable by url.Parse.
+// Malformed values are reported when the command line is parsed.
+func URLFlag(name, description string, options ...FlagOption) CLI {
+	return registerFlag(cliFlag{name: name, description: description, kind: urlKind}, options)
+}
+
+// TimeFlag registers a flag whose value must be parsable by time.Parse using the provided format.
+// Malformed values are reported when the command line is parsed.
+func TimeFlag(name, format, description string, options ...FlagOption) CLI {
+	return registerFlag(cliFlag{name: name, description: description, kind: timeKind, format: format}, options)
+}
+
+// MultiFlag registers a flag which may be supplied multiple times (ex: -tag a -tag b).
+// Each occurrence becomes one of the values returned by Strings, in the order supplied.
+// A value supplied by the environment variable bound to the flag (see Env) is split on "|"
+// (see EnvSeparator) unless the separator is escaped with a backslash or enclosed in double quotes.
+func MultiFlag(name, description string, options ...FlagOption) CLI {
+	return registerFlag(cliFlag{name: name, description: description, kind: stringKind, multi: true, separator: "|"}, options)
+}
+
+func registerFlag(template cliFlag, options []FlagOption) CLI {
+	return func(this *CLISource) {
+		definition := new(cliFlag)
+		*definition = template
+		for _, option := range options {
+			option(definition)
+		}
+
+		this.registry[definition.name] = definition
+		this.flags.Var(definition, definition.name, definition.usage())
+		if definition.short != 0 {
+			this.flags.Var(definition, string(definition.short), definition.usage())
+		}
+	}
+}
+
+// FlagOption configures an individual flag registered with a CLISource.
+type FlagOption func(*cliFlag)
+
+// Short registers a single-character alias for the flag (ex: Short('v') for -v in addition to -verbose,
+// or --verbose when using GNUStyle).
+func Short(alias rune) FlagOption {
+	return func(this *cliFlag) { this.short = alias }
+}
+
+// Env binds the flag to the named environment variable, which supplies the value of the flag when
+// it isn't provided on the command line. The value is validated just like a command line value and
+// takes precedence over the name derived by an EnvironmentSource. The variable is listed in the usage
+// text of the flag.
+func Env(variable string) FlagOption {
+	return func(this *cliFlag) { this.environment = variable }
+}
+
+// EnvSeparator sets the separator used to split the value of the environment variable bound to a
+// MultiFlag (see Env) into multiple values. The default separator is "|".
+func EnvSeparator(separator string) FlagOption {
+	return func(this *cliFlag) { this.separator = separator }
+}
+
+// CompleteFiles causes generated shell completion scripts (see Completion) to complete
+// the value of the flag with file paths.
+func CompleteFiles() FlagOption {
+	return func(this *cliFlag) { this.files = true }
+}
+
+// CompleteValues causes generated shell completion scripts (see Completion) to complete
+// the value of the flag with the provided values.
+func CompleteValues(values ...string) FlagOption {
+	return func(this *cliFlag) { this.completions = values }
+}
+
+// Required marks the flag as required in the output of PrintHelp. Because the value may be
+// supplied by any source provided to the Reader, it is not enforced when parsing the command line.
+func Required() FlagOption {
+	return func(this *cliFlag) { this.required = true }
+}
+
+// GNUStyle parses the command line according to GNU conventions rather than those of the flag package:
+// long options are supplied as --name=value or --name value, short aliases (see Short) as -n value or
+// -nvalue, short boolean aliases may be combined (ex: -abc), and -- marks the end of the options.
+func GNUStyle() CLI {
+	return func(this *CLISource) { this.gnu = true }
 }
 
 // Usage appends a custom message to the end of what is normally printed
@@ -77,16 +197,101 @@ func SetOutput(writer io.Writer) CLI {
 	return func(this *CLISource) { this.flags.SetOutput(writer); this.output = writer }
 }
 
-// Initialize parses the internal *flag.FlagSet. Call only after making all Flag calls.
+// Initialize parses the command line (see Parse). Call only after making all Flag calls.
 func (this *CLISource) Initialize() {
-	this.flags.Usage = this.usage
-	this.flags.Parse(this.source[1:])
-	this.flags.Visit(this.visitor)
+	_ = this.Parse()
+}
+
+// Parse parses the command line and returns any resulting error (ex: an unknown flag or a malformed
+// value), subject to the flag.ErrorHandling mode (see ContinueOnError). The command line is parsed
+// only once, no matter how many times Parse (or Initialize) is called, so a single CLISource can be
+// shared by other sources (see FromCLISourceConfigFile).
+func (this *CLISource) Parse() error {
+	if !this.parsed {
+		this.parsed = true
+		this.err = this.parse(this.source[1:])
+		this.collect()
+	}
+	return this.err
+}
+
+func (this *CLISource) parse(arguments []string) error {
+	if this.gnu {
+		if err := this.parseGNU(arguments); err != nil {
+			return err
+		}
+	} else if err := this.flags.Parse(arguments); err != nil {
+		return err
+	} else {
+		this.arguments = this.flags.Args()
+	}
+
+	if err := this.parseEnvironment(); err != nil {
+		return err
+	}
+
+	if len(this.commands) > 0 {
+		return this.parseCommand()
+	}
+	return this.parsePositionals()
+}
+
+// parseEnvironment supplies the values of flags not provided on the command line
+// from the environment variables bound to them (see Env).
+func (this *CLISource) parseEnvironment() error {
+	for _, definition := range this.registry {
+		if len(definition.environment) == 0 || len(definition.values) > 0 {
+			continue
+		}
+
+		value := os.Getenv(definition.environment)
+		if len(value) == 0 {
+			continue
+		}
+
+		values := []string{value}
+		if definition.multi {
+			split, err := splitEscaped(value, definition.separator)
+			if err != nil {
+				return this.fail(fmt.Errorf("invalid value %q for environment variable %s (flag -%s): %s", value, definition.environment, definition.name, err))
+			}
+			values = split
+		}
+		for _, item := range values {
+			if err := definition.Set(item); err != nil {
+				return this.fail(fmt.Errorf("invalid value %q for environment variable %s (flag -%s): %s", item, definition.environment, definition.name, err))
+			}
+		}
+		definition.fromEnvironment = true
+	}
+	return nil
+}
+
+func (this *CLISource) collect() {
+	for name, definition := range this.registry {
+		if len(definition.values) > 0 {
+			this.values[name] = definition.values
+		}
+	}
+	for _, positional := range this.positionals {
+		if len(positional.values) > 0 {
+			this.values[positional.name] = positional.values
+		}
+	}
+
+	if this.selected != nil {
+		this.selected.collect()
+	}
 }
 
 func (this *CLISource) usage() {
-	fmt.Fprintf(this.out(), "Usage of %s:\n", os.Args[0])
-	this.flags.PrintDefaults()
+	fmt.Fprintf(this.out(), "Usage of %s:\n", this.program())
+	if len(this.description) > 0 {
+		fmt.Fprintln(this.out(), this.description)
+	}
+	this.printDefaults()
+	this.printPositionals()
+	this.printCommands()
 	fmt.Fprintln(this.out(), this.usageMessage)
 }
 
@@ -97,19 +302,18 @@ func (this *CLISource) out() io.Writer {
 	return this.output
 }
 
-func (this *CLISource) visitor(flag *flag.Flag) {
-	if b, found := this.boolRegistry[flag.Name]; found {
-		this.values[flag.Name] = strconv.FormatBool(*b)
-	} else {
-		this.values[flag.Name] = *this.registry[flag.Name]
+// Strings returns the matching command line flag value(s), or KeyNotFound.
+// The flags of the selected subcommand (if any) take precedence over those of its parent(s).
+func (this *CLISource) Strings(key string) ([]string, error) {
+	if this.selected != nil {
+		if values, err := this.selected.Strings(key); err == nil {
+			return values, nil
+		}
 	}
-}
 
-// Strings returns the matching command line flag value, or KeyNotFound.
-func (this *CLISource) Strings(key string) ([]string, error) {
-	value, found := this.values[key]
+	values, found := this.values[key]
 	if !found {
 		return nil, ErrKeyNotFound
 	}
-	return []string{value}, nil
+	return values, nil
 }
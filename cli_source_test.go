@@ -3,7 +3,10 @@ package configo
 import (
 	"bytes"
 	"fmt"
+	"os"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/smartystreets/assertions/should"
 	"github.com/smartystreets/gunit"
@@ -110,3 +113,541 @@ func (this *CLISourceFixture) TestUsageMessage() {
 	this.So(buffer.String(), should.ContainSubstring, "yeah")
 	this.So(buffer.String(), should.ContainSubstring, "This is some helpful text")
 }
+
+func (this *CLISourceFixture) TestTypedFlagsAcceptValidValues() {
+	this.source = FromCLI(
+		IntFlag("port", "The port"),
+		FloatFlag("ratio", "The ratio"),
+		DurationFlag("timeout", "The timeout"),
+		URLFlag("address", "The address"),
+		TimeFlag("since", DateFormat, "The date"),
+	)
+	this.source.source = []string{"./app", "-port=8080", "-ratio", "0.5", "-timeout=5s", "-address=http://localhost", "-since=2015-09-15"}
+	this.source.Initialize()
+
+	reader := NewReader(this.source)
+	this.So(reader.Int("port"), should.Equal, 8080)
+	this.So(reader.String("ratio"), should.Equal, "0.5")
+	this.So(reader.Duration("timeout"), should.Equal, time.Second*5)
+	this.So(reader.String("address"), should.Equal, "http://localhost")
+	this.So(reader.String("since"), should.Equal, "2015-09-15")
+}
+
+func (this *CLISourceFixture) TestTypedFlagsRejectMalformedValues() {
+	for _, option := range []CLI{
+		IntFlag("flag", ""),
+		FloatFlag("flag", ""),
+		DurationFlag("flag", ""),
+		URLFlag("flag", ""),
+		TimeFlag("flag", DateFormat, ""),
+	} {
+		buffer := new(bytes.Buffer)
+		source := FromCLI(option, ContinueOnError(), SetOutput(buffer))
+		source.source = []string{"./app", "-flag=%%bad%%"}
+		source.Initialize()
+
+		this.So(buffer.String(), should.ContainSubstring, `invalid value "%%bad%%" for flag -flag`)
+		_, err := source.Strings("flag")
+		this.So(err, should.Equal, ErrKeyNotFound)
+	}
+}
+
+func (this *CLISourceFixture) TestMultiFlagCollectsEveryOccurrence() {
+	this.source = FromCLI(MultiFlag("tag", "A tag"), Flag("single", "A single value"))
+	this.source.source = []string{"./app", "-tag", "a", "-single=1", "-tag=b", "-single=2"}
+	this.source.Initialize()
+
+	values, err := this.source.Strings("tag")
+	this.So(values, should.Resemble, []string{"a", "b"})
+	this.So(err, should.BeNil)
+
+	values, err = this.source.Strings("single")
+	this.So(values, should.Resemble, []string{"2"})
+	this.So(err, should.BeNil)
+}
+
+func (this *CLISourceFixture) TestGNUStyleLongAndShortOptions() {
+	this.source = FromCLI(
+		GNUStyle(),
+		BoolFlag("verbose", "Verbose output", Short('v')),
+		BoolFlag("all", "All of them", Short('a')),
+		BoolFlag("quiet", "Quiet output", Short('q')),
+		IntFlag("port", "The port", Short('p')),
+		Flag("listen", "The address"),
+		Flag("name", "The name", Short('n')),
+		MultiFlag("tag", "A tag", Short('t')),
+	)
+	this.source.source = []string{"./app", "-vap", "8080", "--listen", ":80", "--name=x=y", "-tone", "-t=two", "--quiet=false", "-a=false", "--", "--tag"}
+	this.source.Initialize()
+
+	this.assertValues("verbose", "true")
+	this.assertValues("all", "false")
+	this.assertValues("quiet", "false")
+	this.assertValues("port", "8080")
+	this.assertValues("listen", ":80")
+	this.assertValues("name", "x=y")
+	this.assertValues("tag", "one", "two")
+	this.So(this.source.arguments, should.Resemble, []string{"--tag"})
+}
+
+func (this *CLISourceFixture) TestGNUStyleErrors() {
+	for arguments, expected := range map[string]string{
+		"--unknown":      "unknown flag: --unknown",
+		"-vx":            "unknown shorthand flag: 'x' in -vx",
+		"--port":         "flag needs an argument: --port",
+		"-vp":            "flag needs an argument: 'p' in -vp",
+		"--port=abc":     `invalid value "abc" for flag --port: strconv.Atoi: parsing "abc": invalid syntax`,
+		"--verbose=nope": `invalid value "nope" for flag --verbose`,
+		"-v=nope":        `invalid value "nope" for flag -v`,
+	} {
+		buffer := new(bytes.Buffer)
+		source := FromCLI(GNUStyle(), ContinueOnError(), SetOutput(buffer),
+			BoolFlag("verbose", "Verbose output", Short('v')),
+			IntFlag("port", "The port", Short('p')),
+		)
+		source.source = []string{"./app", arguments}
+
+		this.So(source.parse(source.source[1:]), should.NotBeNil)
+		this.So(buffer.String(), should.ContainSubstring, expected)
+		this.So(buffer.String(), should.ContainSubstring, "  -p, --port int")
+	}
+}
+
+func (this *CLISourceFixture) TestShortAliasWithStandardParser() {
+	this.source = FromCLI(BoolFlag("verbose", "Verbose output", Short('v')))
+	this.source.source = []string{"./app", "-v"}
+	this.source.Initialize()
+
+	this.assertValues("verbose", "true")
+}
+
+func (this *CLISourceFixture) TestShortAliasListedOnceInStandardUsage() {
+	buffer := new(bytes.Buffer)
+	this.source = FromCLI(ContinueOnError(), SetOutput(buffer),
+		MultiFlag("tag", "The tags", Short('t')),
+		DurationFlag("timeout", "The timeout"),
+	)
+
+	this.So(this.source.parse([]string{"-help"}), should.NotBeNil)
+	this.So(buffer.String(), should.ContainSubstring, "  -t, -tag value\n    \tThe tags\n  -timeout duration\n    \tThe timeout\n")
+	this.So(strings.Count(buffer.String(), "The tags"), should.Equal, 1)
+}
+
+func (this *CLISourceFixture) assertValues(key string, expected ...string) {
+	values, err := this.source.Strings(key)
+	this.So(values, should.Resemble, expected)
+	this.So(err, should.BeNil)
+}
+
+func (this *CLISourceFixture) TestSubcommandFlagsAreParsedAndExposed() {
+	this.source = FromCLI(
+		BoolFlag("verbose", "Verbose output"),
+		Command("serve", "Serves requests",
+			Flag("listen", "The address"),
+			Command("tls", "Serves requests over TLS", Flag("cert", "The certificate")),
+		),
+		Command("migrate", "Migrates the database", Flag("listen", "Not selected")),
+	)
+	this.source.source = []string{"./app", "-verbose", "serve", "-listen=:80", "tls", "-cert", "cert.pem"}
+	this.source.Initialize()
+
+	this.So(this.source.CommandPath(), should.Resemble, []string{"serve", "tls"})
+	this.assertValues("verbose", "true")
+	this.assertValues("listen", ":80")
+	this.assertValues("cert", "cert.pem")
+}
+
+func (this *CLISourceFixture) TestUnselectedSubcommandFlagsAreNotExposed() {
+	this.source = FromCLI(
+		Command("serve", "Serves requests", Flag("listen", "The address")),
+		Command("check", "Checks the configuration"),
+	)
+	this.source.source = []string{"./app", "check"}
+	this.source.Initialize()
+
+	values, err := this.source.Strings("listen")
+	this.So(this.source.CommandPath(), should.Resemble, []string{"check"})
+	this.So(values, should.BeNil)
+	this.So(err, should.Equal, ErrKeyNotFound)
+}
+
+func (this *CLISourceFixture) TestGNUStyleSubcommand() {
+	this.source = FromCLI(GNUStyle(), Command("serve", "Serves requests", Flag("listen", "The address", Short('l'))))
+	this.source.source = []string{"./app", "serve", "-l", ":80"}
+	this.source.Initialize()
+
+	this.So(this.source.CommandPath(), should.Resemble, []string{"serve"})
+	this.assertValues("listen", ":80")
+}
+
+func (this *CLISourceFixture) TestUnknownSubcommand() {
+	buffer := new(bytes.Buffer)
+	this.source = FromCLI(
+		ContinueOnError(),
+		SetOutput(buffer),
+		Command("serve", "Serves requests"),
+		Command("migrate", "Migrates the database"),
+	)
+
+	err := this.source.parse([]string{"deploy"})
+
+	this.So(err, should.NotBeNil)
+	this.So(buffer.String(), should.ContainSubstring, "unknown command: deploy")
+	this.So(buffer.String(), should.ContainSubstring, "Commands:\n  migrate  Migrates the database\n  serve    Serves requests\n")
+}
+
+func (this *CLISourceFixture) TestSubcommandUsage() {
+	buffer := new(bytes.Buffer)
+	this.source = FromCLI(
+		ContinueOnError(),
+		SetOutput(buffer),
+		Command("serve", "Serves requests", Flag("listen", "The address"), Usage("Serve usage")),
+	)
+
+	this.source.parse([]string{"serve", "-help"})
+
+	this.So(buffer.String(), should.ContainSubstring, " serve:\nServes requests\n")
+	this.So(buffer.String(), should.ContainSubstring, "-listen")
+	this.So(buffer.String(), should.ContainSubstring, "Serve usage")
+}
+
+func (this *CLISourceFixture) TestPositionalArguments() {
+	this.source = FromCLI(
+		Flag("mode", "The mode"),
+		Argument("source", "The source"),
+		OptionalArgument("destination", "The destination"),
+		VariadicArgument("files", "The files"),
+	)
+	this.source.source = []string{"./app", "-mode=copy", "from", "to", "a.txt", "b.txt"}
+	this.source.Initialize()
+
+	reader := NewReader(this.source)
+	this.So(reader.String("mode"), should.Equal, "copy")
+	this.So(reader.String("source"), should.Equal, "from")
+	this.So(reader.String("destination"), should.Equal, "to")
+	this.So(reader.Strings("files"), should.Resemble, []string{"a.txt", "b.txt"})
+}
+
+func (this *CLISourceFixture) TestOptionalPositionalArgumentsNotSupplied() {
+	this.source = FromCLI(Argument("source", "The source"), OptionalArgument("destination", "The destination"))
+	this.source.source = []string{"./app", "from"}
+	this.source.Initialize()
+
+	this.assertValues("source", "from")
+	_, err := this.source.Strings("destination")
+	this.So(err, should.Equal, ErrKeyNotFound)
+}
+
+func (this *CLISourceFixture) TestPositionalArgumentErrors() {
+	for expected, arguments := range map[string][]string{
+		"missing required argument: source": {},
+		"too many arguments: extra more":    {"from", "to", "extra", "more"},
+	} {
+		buffer := new(bytes.Buffer)
+		source := FromCLI(ContinueOnError(), SetOutput(buffer),
+			Argument("source", "The source"),
+			OptionalArgument("destination", "The destination"),
+		)
+
+		this.So(source.parse(arguments), should.NotBeNil)
+		this.So(buffer.String(), should.ContainSubstring, expected)
+		this.So(buffer.String(), should.ContainSubstring, "Arguments:\n  source (required)\n    \tThe source\n  destination\n")
+	}
+}
+
+func (this *CLISourceFixture) TestPositionalArgumentsMustBeRegisteredInOrder() {
+	this.So(func() { FromCLI(VariadicArgument("files", ""), Argument("source", "")) }, should.Panic)
+	this.So(func() { FromCLI(OptionalArgument("destination", ""), Argument("source", "")) }, should.Panic)
+}
+
+func (this *CLISourceFixture) TestPositionalArgumentsAndCommandsAreExclusive() {
+	this.So(func() { FromCLI(Argument("source", ""), Command("serve", "")) }, should.Panic)
+	this.So(func() { FromCLI(Command("serve", ""), Argument("source", "")) }, should.Panic)
+	this.So(func() { FromCLI(Command("serve", "", Argument("root", ""))) }, should.NotPanic)
+}
+
+func (this *CLISourceFixture) TestPrintHelpShowsEffectiveValues() {
+	buffer := new(bytes.Buffer)
+	this.source = FromCLI(
+		SetOutput(buffer),
+		Flag("listen", "The address", Required()),
+		IntFlag("port", "The port"),
+		Flag("token", "The token", Required()),
+		BoolFlag("verbose", "Verbose output"),
+	)
+	this.source.source = []string{"./app", "-verbose"}
+	reader := NewReader(
+		this.source,
+		FromEnvironmentMap(nil, EnvironmentPrefix("APP_"), EnvironmentUpperCase()),
+		NewDefaultSource(Default("listen", ":8080")),
+	)
+
+	this.source.PrintHelp(reader)
+
+	output := buffer.String()
+	this.So(output, should.ContainSubstring, "  -listen value (required)\n    \tThe address\n    \tvalue: \":8080\" (from DefaultSource)\n    \tenvironment: APP_LISTEN\n")
+	this.So(output, should.ContainSubstring, "  -port int\n    \tThe port\n    \tvalue: (not set)\n    \tenvironment: APP_PORT\n")
+	this.So(output, should.ContainSubstring, "  -token value (required, missing)\n")
+	this.So(output, should.ContainSubstring, "  -verbose\n    \tVerbose output\n    \tvalue: \"true\" (from CLISource)\n")
+}
+
+func (this *CLISourceFixture) TestPrintHelpShowsValueFromBoundEnvironmentVariable() {
+	setEnvironment("CONFIGO_CLI_PORT", "9")
+	defer os.Unsetenv("CONFIGO_CLI_PORT")
+
+	buffer := new(bytes.Buffer)
+	this.source = FromCLI(SetOutput(buffer),
+		IntFlag("port", "The port", Env("CONFIGO_CLI_PORT")),
+		Flag("listen", "The address", Env("CONFIGO_CLI_LISTEN")),
+	)
+	this.source.source = []string{"./app", "-listen", ":80"}
+	this.source.Initialize()
+
+	this.source.PrintHelp(NewReader(this.source))
+
+	this.So(buffer.String(), should.ContainSubstring, "    \tvalue: \"9\" (from environment CONFIGO_CLI_PORT)\n")
+	this.So(buffer.String(), should.ContainSubstring, "    \tvalue: \":80\" (from CLISource)\n")
+}
+
+func (this *CLISourceFixture) TestPrintHelpShowsUpperCaseEnvironmentVariable() {
+	for source, expected := range map[*EnvironmentSource]string{
+		FromEnvironment():                                          "LISTEN",
+		FromEnvironmentWithPrefix("app_"):                          "APP_LISTEN",
+		FromCustomEnvironment(EnvironmentExactCase()):              "listen",
+		FromCustomEnvironment(EnvironmentKey("listen", "Address")): "Address",
+	} {
+		buffer := new(bytes.Buffer)
+		this.source = FromCLI(SetOutput(buffer), Flag("listen", "The address"))
+		this.source.source = []string{"./app"}
+
+		this.source.PrintHelp(NewReader(this.source, source))
+
+		this.So(buffer.String(), should.ContainSubstring, "    \tenvironment: "+expected+"\n")
+	}
+}
+
+func (this *CLISourceFixture) TestPrintHelpFormatsFlagsLikeTheParser() {
+	buffer := new(bytes.Buffer)
+	this.source = FromCLI(
+		SetOutput(buffer),
+		GNUStyle(),
+		Flag("listen", "The address", Short('l')),
+		BoolFlag("verbose", "Verbose output"),
+		Command("serve", "Serve requests", Argument("root", "The document root")),
+		Command("stop", "Stop serving"),
+	)
+	this.source.source = []string{"./app", "-l", ":80", "serve", "/var/www"}
+	this.source.Initialize()
+
+	this.source.PrintHelp(NewReader(this.source))
+
+	output := buffer.String()
+	this.So(output, should.ContainSubstring, "  -l, --listen value\n    \tThe address\n    \tvalue: \":80\" (from CLISource)\n")
+	this.So(output, should.ContainSubstring, "      --verbose\n    \tVerbose output\n")
+	this.So(output, should.ContainSubstring, "Arguments:\n  root (required)\n    \tThe document root\n")
+}
+
+func (this *CLISourceFixture) TestPrintHelpListsCommands() {
+	buffer := new(bytes.Buffer)
+	this.source = FromCLI(
+		SetOutput(buffer),
+		Command("serve", "Serve requests"),
+		Command("stop", "Stop serving"),
+	)
+	this.source.source = []string{"./app"}
+	this.source.Initialize()
+
+	this.source.PrintHelp(NewReader(this.source))
+
+	this.So(buffer.String(), should.ContainSubstring, "Commands:\n  serve  Serve requests\n  stop   Stop serving\n")
+}
+
+func (this *CLISourceFixture) TestPrintHelpNamesSourceWithinMultiSource() {
+	buffer := new(bytes.Buffer)
+	this.source = FromCLI(SetOutput(buffer), Flag("listen", "The address"))
+	this.source.source = []string{"./app"}
+	reader := NewReader(this.source, MultiSource{NewDefaultSource(Default("listen", ":8080"))})
+
+	this.source.PrintHelp(reader)
+
+	this.So(buffer.String(), should.ContainSubstring, "    \tvalue: \":8080\" (from DefaultSource)\n")
+}
+
+func (this *CLISourceFixture) TestFlagBoundToEnvironmentVariable() {
+	setEnvironment("CONFIGO_CLI_LISTEN", ":9090")
+	setEnvironment("CONFIGO_CLI_TAGS", "a|b")
+	defer os.Unsetenv("CONFIGO_CLI_LISTEN")
+	defer os.Unsetenv("CONFIGO_CLI_TAGS")
+
+	this.source = FromCLI(
+		Flag("listen", "The address", Env("CONFIGO_CLI_LISTEN")),
+		MultiFlag("tag", "The tags", Env("CONFIGO_CLI_TAGS")),
+		Flag("name", "The name", Env("CONFIGO_CLI_UNSET")),
+	)
+	this.source.source = []string{"./app"}
+	this.source.Initialize()
+
+	this.assertValues("listen", ":9090")
+	this.assertValues("tag", "a", "b")
+	_, err := this.source.Strings("name")
+	this.So(err, should.Equal, ErrKeyNotFound)
+}
+
+func (this *CLISourceFixture) TestMultiFlagEnvironmentVariableSeparator() {
+	setEnvironment("CONFIGO_CLI_TAGS", `a\|b|"c|d"`)
+	setEnvironment("CONFIGO_CLI_NAMES", "x,y|z")
+	defer os.Unsetenv("CONFIGO_CLI_TAGS")
+	defer os.Unsetenv("CONFIGO_CLI_NAMES")
+
+	this.source = FromCLI(
+		MultiFlag("tag", "The tags", Env("CONFIGO_CLI_TAGS")),
+		MultiFlag("name", "The names", Env("CONFIGO_CLI_NAMES"), EnvSeparator(",")),
+	)
+	this.source.source = []string{"./app"}
+	this.source.Initialize()
+
+	this.assertValues("tag", "a|b", "c|d")
+	this.assertValues("name", "x", "y|z")
+}
+
+func (this *CLISourceFixture) TestMultiFlagEnvironmentVariableWithUnterminatedQuote() {
+	setEnvironment("CONFIGO_CLI_TAGS", `a|"b`)
+	defer os.Unsetenv("CONFIGO_CLI_TAGS")
+
+	this.source = FromCLI(ContinueOnError(), SetOutput(new(bytes.Buffer)), MultiFlag("tag", "The tags", Env("CONFIGO_CLI_TAGS")))
+
+	this.So(this.source.parse(nil), should.NotBeNil)
+}
+
+func (this *CLISourceFixture) TestCommandLineTakesPrecedenceOverBoundEnvironmentVariable() {
+	setEnvironment("CONFIGO_CLI_LISTEN", ":9090")
+	defer os.Unsetenv("CONFIGO_CLI_LISTEN")
+
+	this.source = FromCLI(Flag("listen", "The address", Env("CONFIGO_CLI_LISTEN")))
+	this.source.source = []string{"./app", "-listen=:80"}
+	this.source.Initialize()
+
+	this.assertValues("listen", ":80")
+}
+
+func (this *CLISourceFixture) TestBoundEnvironmentVariableIsValidated() {
+	setEnvironment("CONFIGO_CLI_PORT", "abc")
+	defer os.Unsetenv("CONFIGO_CLI_PORT")
+
+	buffer := new(bytes.Buffer)
+	this.source = FromCLI(ContinueOnError(), SetOutput(buffer), IntFlag("port", "The port", Env("CONFIGO_CLI_PORT")))
+
+	this.So(this.source.parse(nil), should.NotBeNil)
+	this.So(buffer.String(), should.ContainSubstring, `invalid value "abc" for environment variable CONFIGO_CLI_PORT (flag -port)`)
+	this.So(buffer.String(), should.ContainSubstring, "The port [$CONFIGO_CLI_PORT]")
+}
+
+func (this *CLISourceFixture) TestCompletionScripts() {
+	this.source = FromCLI(
+		BoolFlag("verbose", "Verbose output", Short('v')),
+		Flag("config", "The config file", CompleteFiles()),
+		Flag("level", "The log level", CompleteValues("debug", "info")),
+		Command("serve", "Serves requests", IntFlag("port", "The port")),
+	)
+
+	bash, err := this.source.Completion("bash", "app")
+	this.So(err, should.BeNil)
+	this.So(bash, should.ContainSubstring, `"/serve") command_path="${command_path}/${word}" ;;`)
+	this.So(bash, should.ContainSubstring, `":-config") COMPREPLY=($(compgen -f -- "$cur")); return ;;`)
+	this.So(bash, should.ContainSubstring, `":-level") for word in 'debug' 'info'; do [[ "$word" == "$cur"* ]] && COMPREPLY+=("$word"); done; return ;;`)
+	this.So(bash, should.ContainSubstring, `"/serve:-port") return ;;`)
+	this.So(bash, should.ContainSubstring, `"") COMPREPLY=($(compgen -W "-config -level -verbose -v serve" -- "$cur")) ;;`)
+	this.So(bash, should.EndWith, "complete -F _app_completion app\n")
+
+	zsh, err := this.source.Completion("zsh", "app")
+	this.So(err, should.BeNil)
+	this.So(zsh, should.StartWith, "#compdef app\n")
+	this.So(zsh, should.ContainSubstring, `":-config") _files; return ;;`)
+	this.So(zsh, should.ContainSubstring, `"/serve") compadd -- -port ;;`)
+
+	fish, err := this.source.Completion("fish", "app")
+	this.So(err, should.BeNil)
+	this.So(fish, should.ContainSubstring, `complete -c app -n 'test "(__app_command_path)" = ""' -a serve -d 'Serves requests'`)
+	this.So(fish, should.ContainSubstring, `complete -c app -n 'test "(__app_command_path)" = ""' -o verbose -s v -d 'Verbose output'`)
+	this.So(fish, should.ContainSubstring, `-o level -r -a 'debug info' -d 'The log level'`)
+	this.So(fish, should.ContainSubstring, `complete -c app -n 'test "(__app_command_path)" = "/serve"' -o port -r -d 'The port'`)
+
+	_, err = this.source.Completion("powershell", "app")
+	this.So(err, should.NotBeNil)
+}
+
+func (this *CLISourceFixture) TestGNUStyleCompletionUsesLongOptions() {
+	this.source = FromCLI(GNUStyle(), BoolFlag("verbose", "Verbose output", Short('v')))
+
+	bash, _ := this.source.Completion("bash", "app")
+	fish, _ := this.source.Completion("fish", "app")
+
+	this.So(bash, should.ContainSubstring, `"--verbose -v"`)
+	this.So(fish, should.ContainSubstring, `-l verbose -s v`)
+}
+
+func (this *CLISourceFixture) TestGNUStyleCompletionAppliesToSubcommands() {
+	this.source = FromCLI(GNUStyle(), Command("serve", "Serves requests", IntFlag("port", "The port")))
+
+	bash, _ := this.source.Completion("bash", "app")
+	zsh, _ := this.source.Completion("zsh", "app")
+	fish, _ := this.source.Completion("fish", "app")
+
+	this.So(bash, should.ContainSubstring, `"/serve:--port") return ;;`)
+	this.So(bash, should.ContainSubstring, `"/serve") COMPREPLY=($(compgen -W "--port" -- "$cur")) ;;`)
+	this.So(zsh, should.ContainSubstring, `"/serve") compadd -- --port ;;`)
+	this.So(fish, should.ContainSubstring, `= "/serve"' -l port -r -d 'The port'`)
+}
+
+func (this *CLISourceFixture) TestCompletionValuesAreQuoted() {
+	this.source = FromCLI(Flag("name", "The name", CompleteValues("it's", "$(rm -rf)", "a b")))
+
+	bash, _ := this.source.Completion("bash", "app")
+	zsh, _ := this.source.Completion("zsh", "app")
+	fish, _ := this.source.Completion("fish", "app")
+
+	this.So(bash, should.ContainSubstring, `for word in 'it'\''s' '$(rm -rf)' 'a b'; do`)
+	this.So(zsh, should.ContainSubstring, `compadd -- 'it'\''s' '$(rm -rf)' 'a b'; return ;;`)
+	this.So(fish, should.ContainSubstring, `-r -a 'it\\\'s \\$\\(rm\\ -rf\\) a\\ b'`)
+}
+
+func (this *CLISourceFixture) TestExplicitArgumentsAndReturnedParseErrors() {
+	buffer := new(bytes.Buffer)
+	this.source = FromCLIArguments([]string{"-port=abc"}, SetOutput(buffer), IntFlag("port", "The port"))
+
+	err := this.source.Parse()
+
+	this.So(err, should.NotBeNil)
+	this.So(err.Error(), should.ContainSubstring, `invalid value "abc" for flag -port`)
+
+	this.source = FromCLIArguments([]string{"-unknown"}, SetOutput(buffer))
+	this.So(this.source.Parse(), should.NotBeNil)
+
+	this.source = FromCLIArguments([]string{"-port=80"}, IntFlag("port", "The port"))
+	this.So(this.source.Parse(), should.BeNil)
+	this.assertValues("port", "80")
+}
+
+func (this *CLISourceFixture) TestCommandLineParsedOnlyOnce() {
+	this.source = FromCLIArguments([]string{"-tag=a", "-tag=b"}, MultiFlag("tag", "The tags"))
+
+	this.source.Initialize()
+	this.source.Initialize()
+	this.So(this.source.Parse(), should.BeNil)
+
+	this.assertValues("tag", "a", "b")
+}
+
+func (this *CLISourceFixture) TestConfigFileSourceSharesCommandLine() {
+	directory := newTempDirectory("cliConfigFile")
+	defer directory.remove()
+	filename := directory.write("override.json", []byte(`{"listen":":9090"}`))
+
+	this.source = FromCLIArguments([]string{"-verbose", "-config", filename}, BoolFlag("verbose", "Verbose output"))
+	configFile := FromCLISourceConfigFile(this.source, "config")
+	reader := NewReader(this.source, configFile)
+
+	this.So(reader.Bool("verbose"), should.BeTrue)
+	this.So(reader.String("config"), should.Equal, filename)
+	this.So(reader.String("listen"), should.Equal, ":9090")
+}
@@ -73,7 +73,7 @@ func convertToString(value interface{}) string {
 	case time.Duration:
 		return typed.String()
 	case time.Time:
-		return typed.String()
+		return typed.Format(time.RFC3339Nano)
 	default:
 		return ""
 	}
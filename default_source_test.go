@@ -94,7 +94,18 @@ func (this *DefaultSourceFixture) TestAddingTimeTypesRetrievesAll() {
 	now := time.Now().UTC()
 	this.addValues(now)
 
-	this.assertValues([]string{now.String()})
+	this.assertValues([]string{now.Format(time.RFC3339Nano)})
+}
+
+func (this *DefaultSourceFixture) TestTimeValuesRoundTripLosslessly() {
+	instant := time.Date(2015, 9, 15, 11, 29, 0, 123456789, time.FixedZone("MDT", -6*60*60))
+	reader := NewReader(NewDefaultSource(Default("key", instant)))
+
+	parsed, err := reader.TimeError("key", time.RFC3339Nano)
+
+	this.So(err, should.BeNil)
+	this.So(parsed.Equal(instant), should.BeTrue)
+	this.So(reader.Time("key", AutoTimeFormat).Equal(instant), should.BeTrue)
 }
 
 func (this *DefaultSourceFixture) addValues(values ...interface{}) {
@@ -1,8 +1,10 @@
 package configo
 
 import (
+	"io/fs"
 	"io/ioutil"
 	"log"
+	"os"
 	"path"
 	"strings"
 )
@@ -12,6 +14,7 @@ type DirectorySource struct {
 	files     map[string]string
 	mustExist bool
 	path      string
+	fsys      fs.FS
 }
 
 // FromDirectory reads the directory path provided. If the path does not exist, a panic will result.
@@ -22,6 +25,25 @@ func FromDirectory(path string) *DirectorySource {
 	}
 }
 
+// FromDirectoryFS reads the directory provided from the fs.FS (such as an embed.FS or fstest.MapFS).
+// If the directory does not exist, a panic will result.
+func FromDirectoryFS(fsys fs.FS, dir string) *DirectorySource {
+	return &DirectorySource{
+		mustExist: true,
+		path:      dir,
+		fsys:      fsys,
+	}
+}
+
+// FromSystemdCredentials reads the credentials directory provided by systemd (via LoadCredential= and
+// similar directives) as identified by the $CREDENTIALS_DIRECTORY environment variable. When not running
+// under systemd (the variable is not set) the resulting source contains no keys.
+func FromSystemdCredentials() *DirectorySource {
+	return FromOptionalDirectory(os.Getenv(systemdCredentialsDirectory))
+}
+
+const systemdCredentialsDirectory = "CREDENTIALS_DIRECTORY"
+
 func FromOptionalDirectories(directories ...string) MultiSource {
 	var sources MultiSource
 	for _, directory := range directories {
@@ -38,6 +60,15 @@ func FromOptionalDirectory(path string) *DirectorySource {
 	}
 }
 
+// FromOptionalDirectoryFS reads the directory provided from the fs.FS, if it exists.
+func FromOptionalDirectoryFS(fsys fs.FS, dir string) *DirectorySource {
+	return &DirectorySource{
+		mustExist: false,
+		path:      dir,
+		fsys:      fsys,
+	}
+}
+
 func (this *DirectorySource) Strings(key string) ([]string, error) {
 	key = sanitizeKey(strings.ToLower(key))
 
@@ -46,7 +77,7 @@ func (this *DirectorySource) Strings(key string) ([]string, error) {
 		return nil, ErrKeyNotFound
 	}
 
-	data, err := ioutil.ReadFile(path.Join(this.path, filename))
+	data, err := this.readFile(path.Join(this.path, filename))
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +88,11 @@ func (this *DirectorySource) Strings(key string) ([]string, error) {
 func (this *DirectorySource) Initialize() {
 	this.files = make(map[string]string, 32)
 
-	if files, err := ioutil.ReadDir(this.path); err != nil {
+	if len(this.path) == 0 && !this.mustExist {
+		return
+	}
+
+	if files, err := this.readDir(this.path); err != nil {
 		log.Printf("[INFO] directory not read [%s]: %s\n", this.path, err)
 		if this.mustExist {
 			panic("directory must exist")
@@ -71,3 +106,17 @@ func (this *DirectorySource) Initialize() {
 		}
 	}
 }
+
+func (this *DirectorySource) readDir(name string) ([]fs.DirEntry, error) {
+	if this.fsys != nil {
+		return fs.ReadDir(this.fsys, name)
+	}
+	return os.ReadDir(name)
+}
+
+func (this *DirectorySource) readFile(name string) ([]byte, error) {
+	if this.fsys != nil {
+		return fs.ReadFile(this.fsys, name)
+	}
+	return ioutil.ReadFile(name)
+}
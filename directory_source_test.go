@@ -6,6 +6,7 @@ import (
 	"path"
 	"strings"
 	"testing"
+	"testing/fstest"
 
 	"github.com/smartystreets/assertions/should"
 	"github.com/smartystreets/gunit"
@@ -105,3 +106,45 @@ func (this *DirectorySourceFixture) TestStringsMissing() {
 	this.So(data, should.BeEmpty)
 	this.So(err, should.Equal, ErrKeyNotFound)
 }
+
+func (this *DirectorySourceFixture) TestStringsFromFS() {
+	fsys := fstest.MapFS{
+		"secrets/Db-Password": {Data: []byte("hunter2")},
+		"secrets/nested/file": {Data: []byte("ignored")},
+	}
+	src := FromDirectoryFS(fsys, "secrets")
+	src.Initialize()
+
+	data, err := src.Strings("db-password")
+	this.So(err, should.BeNil)
+	this.So(data, should.Resemble, []string{"hunter2"})
+	this.So(len(src.files), should.Equal, 1)
+}
+
+func (this *DirectorySourceFixture) TestBadDirectoryFromFS() {
+	this.So(func() { FromDirectoryFS(fstest.MapFS{}, "missing").Initialize() }, should.Panic)
+	this.So(func() { FromOptionalDirectoryFS(fstest.MapFS{}, "missing").Initialize() }, should.NotPanic)
+}
+
+func (this *DirectorySourceFixture) TestSystemdCredentials() {
+	defer os.Unsetenv(systemdCredentialsDirectory)
+	_ = os.Setenv(systemdCredentialsDirectory, this.dirPath)
+
+	src := FromSystemdCredentials()
+	src.Initialize()
+
+	data, err := src.Strings("file1")
+	this.So(err, should.BeNil)
+	this.So(data, should.Resemble, []string{"My file contents"})
+}
+
+func (this *DirectorySourceFixture) TestSystemdCredentialsNotRunningUnderSystemd() {
+	_ = os.Unsetenv(systemdCredentialsDirectory)
+
+	src := FromSystemdCredentials()
+	this.So(src.Initialize, should.NotPanic)
+
+	data, err := src.Strings("file1")
+	this.So(data, should.BeEmpty)
+	this.So(err, should.Equal, ErrKeyNotFound)
+}
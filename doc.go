@@ -26,10 +26,25 @@
 //     func (*Reader) String(key string) string
 //     func (*Reader) Ints(key string) []int
 //     func (*Reader) Int(key string) int
+//     func (*Reader) Int64(key string) int64 (and Int32, Int16, Int8, Uint, Uint64, etc...)
+//     func (*Reader) Float64(key string) float64 (and Float32)
+//     func (*Reader) ByteSize(key string) uint64 (ex: 512MiB, 1.5GB)
+//     func (*Reader) Quantity(key string) int64 (ex: 10k)
+//     func (*Reader) IP(key string) net.IP (and IPNet, Prefix, AddrPort, HostPort)
+//     func (*Reader) StringMap(key string) map[string]string (ex: region=us-east,tier=gold)
+//     func (*Reader) Enum(key string, enum *Enum) string (ex: debug, info, warn, error)
+//     func (*Reader) Regexp(key string) *regexp.Regexp (and Template)
+//     func (*Reader) Path(key string, options ...PathOption) string (ex: ~/app/$ENV.json)
 //     func (*Reader) Bool(key string) bool
 //     func (*Reader) URLs(key string) []net.url.URL
 //     func (*Reader) URL(key string) net.url.URL
 //
+// Values of any type may also be fetched with the generic Get functions, using
+// the parsers registered with RegisterParser (or the built-in parsers):
+//
+//     func Get[T any](reader *Reader, key string) T
+//     func GetAll[T any](reader *Reader, key string) []T
+//
 // For each of the types returned above there are different ways to handle
 // the scenario when a key is not found. I'll illustrate this with the
 // applicable Int functions (but similar methods are implemented for each
@@ -50,6 +65,21 @@
 //     // returns the value or calls log.Fatal() if the key is not found or the values are malformed.
 //     func (*Reader) IntFatal(key string) int
 //
+// The errors returned (or passed to log.Fatal) are a *KeyError, which identifies the key (and
+// its aliases), or a *ParseError, which also identifies the source and raw value of the key. They
+// are compatible with ErrKeyNotFound and ErrMalformedValue, respectively (according to errors.Is).
+// Because they are no longer the sentinel errors themselves, compare them using errors.Is (rather
+// than ==):
+//
+//     if _, err := reader.IntError("port"); errors.Is(err, configo.ErrKeyNotFound) {
+//         // ...
+//     }
+//
+// Rather than failing on the first missing or malformed key, all required keys
+// can be checked at once, resulting in a single report:
+//
+//     reader.Require("name").Int("port").Duration("timeout").ValidateFatal()
+//
 // Here's a full example:
 //
 //     reader := configo.NewReader(
@@ -1,6 +1,8 @@
 package configo
 
 import (
+	"encoding/json"
+	"errors"
 	"os"
 	"strings"
 	"unicode"
@@ -8,10 +10,26 @@ import (
 
 // EnvironmentSource reads key-value pairs from the environment.
 type EnvironmentSource struct {
-	prefix    string
-	separator string
+	prefix       string
+	separator    string
+	replacements []string
+	overrides    map[string]string
+	mapper       func(string) string
+	casing       environmentCasing
+	escaping     bool
+	jsonArrays   bool
+	snapshot     bool
+	variables    map[string]string
 }
 
+type environmentCasing int
+
+const (
+	anyCase environmentCasing = iota
+	exactCase
+	upperCase
+)
+
 // FromEnvironment creates an environment source capable of
 // parsing values separated by the pipe character.
 func FromEnvironment() *EnvironmentSource {
@@ -31,24 +49,249 @@ func FromEnvironmentCustomSeparator(prefix, separator string) *EnvironmentSource
 	return &EnvironmentSource{prefix: prefix, separator: separator}
 }
 
+// FromCustomEnvironment creates an environment source (parsing values separated by the pipe character)
+// configured by the provided options, which control how keys are mapped to environment variable names.
+func FromCustomEnvironment(options ...Environment) *EnvironmentSource {
+	source := FromEnvironment()
+	for _, option := range options {
+		option(source)
+	}
+	return source
+}
+
+// FromEnvironmentList creates an environment source which reads from the provided list of
+// "key=value" pairs (in the format returned by os.Environ()) rather than the process environment.
+func FromEnvironmentList(variables []string, options ...Environment) *EnvironmentSource {
+	return FromEnvironmentMap(parseEnvironment(variables), options...)
+}
+
+// FromEnvironmentMap creates an environment source which reads from the provided map of
+// variable names to values rather than the process environment.
+func FromEnvironmentMap(variables map[string]string, options ...Environment) *EnvironmentSource {
+	source := FromCustomEnvironment(options...)
+	source.variables = make(map[string]string, len(variables))
+	for name, value := range variables {
+		source.variables[name] = value
+	}
+	return source
+}
+
+type Environment func(*EnvironmentSource)
+
+// EnvironmentSnapshot captures the process environment once, during Initialize, so that
+// subsequent changes to the environment don't affect the values returned by the source.
+func EnvironmentSnapshot() Environment {
+	return func(this *EnvironmentSource) { this.snapshot = true }
+}
+
+// EnvironmentPrefix sets the prefix which begins the name of every environment variable.
+func EnvironmentPrefix(prefix string) Environment {
+	return func(this *EnvironmentSource) { this.prefix = prefix }
+}
+
+// EnvironmentSeparator sets the separator used to split a value into multiple values.
+func EnvironmentSeparator(separator string) Environment {
+	return func(this *EnvironmentSource) { this.separator = separator }
+}
+
+// EnvironmentNesting maps the separator of nested keys (ex: "db.host") to the separator used in
+// environment variable names (ex: "__" resulting in "DB__HOST").
+func EnvironmentNesting(keySeparator, variableSeparator string) Environment {
+	return EnvironmentReplace(keySeparator, variableSeparator)
+}
+
+// EnvironmentReplace replaces all occurrences of old in a key with new before the remaining
+// non-alphanumeric characters are replaced with an underscore. Replacements are applied in
+// the order they are registered.
+func EnvironmentReplace(old, new string) Environment {
+	return func(this *EnvironmentSource) { this.replacements = append(this.replacements, old, new) }
+}
+
+// EnvironmentKey maps a key to an explicitly named environment variable. The variable name
+// is used exactly as provided (without prefix or changes to casing).
+func EnvironmentKey(key, variable string) Environment {
+	return func(this *EnvironmentSource) {
+		if this.overrides == nil {
+			this.overrides = make(map[string]string)
+		}
+		this.overrides[key] = variable
+	}
+}
+
+// EnvironmentKeyMapper replaces the default key mapping (replacements followed by
+// converting all non-alphanumeric characters to an underscore) with the provided callback.
+// The prefix is still prepended to the result.
+func EnvironmentKeyMapper(mapper func(key string) string) Environment {
+	return func(this *EnvironmentSource) { this.mapper = mapper }
+}
+
+// EnvironmentExactCase only looks up the environment variable with the casing that results from
+// mapping the key (rather than also trying the upper and lower case variants).
+func EnvironmentExactCase() Environment {
+	return func(this *EnvironmentSource) { this.casing = exactCase }
+}
+
+// EnvironmentUpperCase only looks up the upper case variant of the mapped environment variable name.
+func EnvironmentUpperCase() Environment {
+	return func(this *EnvironmentSource) { this.casing = upperCase }
+}
+
+// EnvironmentEscaping allows values to contain the separator, either by escaping it with a backslash
+// (ex: a\|b|c results in the values "a|b" and "c") or by enclosing it in double quotes (ex: "a|b"|c).
+// A backslash also escapes a double quote or another backslash; before any other character it is kept
+// as is (ex: C:\dir). A value with an unterminated double quote results in a *ParseError.
+func EnvironmentEscaping() Environment {
+	return func(this *EnvironmentSource) { this.escaping = true }
+}
+
+// EnvironmentJSONArrays parses values formatted as a JSON array (ex: ["a","b"]) into multiple values.
+// Values which are not valid JSON arrays are split on the separator as usual.
+func EnvironmentJSONArrays() Environment {
+	return func(this *EnvironmentSource) { this.jsonArrays = true }
+}
+
 // Strings reads the environment variable specified by key and returns the value or ErrKeyNotFound.
 func (this *EnvironmentSource) Strings(key string) ([]string, error) {
-	key = this.prefix + sanitizeKey(key)
+	for _, name := range this.VariableNames(key) {
+		if value := this.getenv(name); len(value) > 0 {
+			return this.split(value)
+		}
+	}
+
+	return nil, ErrKeyNotFound
+}
+func (this *EnvironmentSource) getenv(name string) string {
+	if this.variables != nil {
+		return this.variables[name]
+	}
+	return os.Getenv(name)
+}
+func (this *EnvironmentSource) split(value string) ([]string, error) {
+	if this.jsonArrays {
+		if values, ok := parseJSONArray(value); ok {
+			return values, nil
+		}
+	}
 
-	if value := os.Getenv(key); len(value) > 0 {
-		return strings.Split(value, this.separator), nil
+	if this.escaping {
+		values, err := splitEscaped(value, this.separator)
+		if err != nil {
+			return nil, &ParseError{Value: value, Err: err}
+		}
+		return values, nil
 	}
 
-	if value := os.Getenv(strings.ToUpper(key)); len(value) > 0 {
-		return strings.Split(value, this.separator), nil
+	return strings.Split(value, this.separator), nil
+}
+func parseJSONArray(value string) (values []string, ok bool) {
+	if !strings.HasPrefix(strings.TrimSpace(value), "[") {
+		return nil, false
 	}
 
-	if value := os.Getenv(strings.ToLower(key)); len(value) > 0 {
-		return strings.Split(value, this.separator), nil
+	var items []interface{}
+	if err := json.Unmarshal([]byte(value), &items); err != nil {
+		return nil, false
 	}
 
-	return nil, ErrKeyNotFound
+	values = make([]string, len(items))
+	for i, item := range items {
+		values[i] = convertToString(item)
+	}
+	return values, true
+}
+func splitEscaped(value, separator string) (values []string, err error) {
+	var current strings.Builder
+	quoted := false
+
+	for i := 0; i < len(value); i++ {
+		switch {
+		case value[i] == '\\' && len(separator) > 0 && strings.HasPrefix(value[i+1:], separator):
+			current.WriteString(separator)
+			i += len(separator)
+		case value[i] == '\\' && i+1 < len(value) && (value[i+1] == '"' || value[i+1] == '\\'):
+			i++
+			current.WriteByte(value[i])
+		case value[i] == '"':
+			quoted = !quoted
+		case !quoted && len(separator) > 0 && strings.HasPrefix(value[i:], separator):
+			values = append(values, current.String())
+			current.Reset()
+			i += len(separator) - 1
+		default:
+			current.WriteByte(value[i])
+		}
+	}
+
+	if quoted {
+		return nil, errUnterminatedQuote
+	}
+	return append(values, current.String()), nil
+}
+
+var errUnterminatedQuote = errors.New("unterminated double quote")
+
+// VariableNames returns the names of the environment variables, in the order they are
+// consulted, that could provide the value of the given key.
+func (this *EnvironmentSource) VariableNames(key string) []string {
+	key = strings.TrimPrefix(key, "env:")
+
+	if variable, found := this.overrides[key]; found {
+		return []string{variable}
+	}
+
+	name := this.prefix + this.mapKey(key)
+
+	switch this.casing {
+	case exactCase:
+		return []string{name}
+	case upperCase:
+		return []string{strings.ToUpper(name)}
+	default:
+		return distinct(name, strings.ToUpper(name), strings.ToLower(name))
+	}
 }
+
+// variableName returns the one of the VariableNames which is most likely to be set: the upper case
+// variant, unless the casing is restricted (see EnvironmentExactCase) or the key is overridden.
+func (this *EnvironmentSource) variableName(key string) string {
+	names := this.VariableNames(key)
+	if this.casing == anyCase {
+		for _, name := range names {
+			if name == strings.ToUpper(name) {
+				return name
+			}
+		}
+	}
+	return names[0]
+}
+func (this *EnvironmentSource) mapKey(key string) string {
+	if this.mapper != nil {
+		return this.mapper(key)
+	}
+
+	for i := 0; i < len(this.replacements); i += 2 {
+		key = strings.Replace(key, this.replacements[i], this.replacements[i+1], -1)
+	}
+
+	return sanitizeKey(key)
+}
+func distinct(values ...string) (unique []string) {
+	for i, value := range values {
+		if !contains(values[:i], value) {
+			unique = append(unique, value)
+		}
+	}
+	return unique
+}
+func contains(values []string, value string) bool {
+	for _, item := range values {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
+
 func sanitizeKey(key string) string {
 	if strings.HasPrefix(key, "env:") {
 		key = key[len("env:"):]
@@ -69,4 +312,18 @@ func sanitizeKey(key string) string {
 	return sanitized
 }
 
-func (this *EnvironmentSource) Initialize() {}
+// Initialize captures the process environment if EnvironmentSnapshot was specified.
+func (this *EnvironmentSource) Initialize() {
+	if this.snapshot && this.variables == nil {
+		this.variables = parseEnvironment(os.Environ())
+	}
+}
+func parseEnvironment(variables []string) map[string]string {
+	parsed := make(map[string]string, len(variables))
+	for _, variable := range variables {
+		if equals := strings.Index(variable, "="); equals >= 0 {
+			parsed[variable[:equals]] = variable[equals+1:]
+		}
+	}
+	return parsed
+}
@@ -74,6 +74,172 @@ func (this *EnvironmentSourceFixture) TestEnvPrefixIsAlwaysStrippedBeforeTheEnvi
 	this.So(err, should.BeNil)
 }
 
+func (this *EnvironmentSourceFixture) TestNestedKeysAndHyphensMappedDistinctly() {
+	this.source = FromCustomEnvironment(
+		EnvironmentPrefix("CONFIGO_"),
+		EnvironmentNesting(".", "__"),
+		EnvironmentReplace("-", "_"),
+	)
+	setEnvironment("CONFIGO_DB__HOST", "nested")
+	setEnvironment("CONFIGO_DB_HOST", "hyphenated")
+
+	nested, _ := this.source.Strings("db.host")
+	hyphenated, _ := this.source.Strings("db-host")
+
+	this.So(nested, should.Resemble, []string{"nested"})
+	this.So(hyphenated, should.Resemble, []string{"hyphenated"})
+}
+
+func (this *EnvironmentSourceFixture) TestExplicitKeyOverride() {
+	this.source = FromCustomEnvironment(EnvironmentPrefix("CONFIGO_"), EnvironmentKey("listen", "PORT_BINDING"))
+	setEnvironment("PORT_BINDING", ":8080")
+
+	values, err := this.source.Strings("listen")
+
+	this.So(values, should.Resemble, []string{":8080"})
+	this.So(err, should.BeNil)
+	this.So(this.source.VariableNames("listen"), should.Resemble, []string{"PORT_BINDING"})
+}
+
+func (this *EnvironmentSourceFixture) TestCustomKeyMapper() {
+	this.source = FromCustomEnvironment(
+		EnvironmentPrefix("CONFIGO_"),
+		EnvironmentKeyMapper(func(key string) string { return "MAPPED_" + key }),
+	)
+
+	this.So(this.source.VariableNames("key"), should.Resemble, []string{"CONFIGO_MAPPED_key", "CONFIGO_MAPPED_KEY", "configo_mapped_key"})
+}
+
+func (this *EnvironmentSourceFixture) TestUpperCaseOnly() {
+	this.source = FromCustomEnvironment(EnvironmentPrefix("configo_"), EnvironmentUpperCase())
+	setEnvironment("configo_strict_upper", "lower")
+
+	values, err := this.source.Strings("strict-upper")
+	this.So(values, should.BeNil)
+	this.So(err, should.Equal, ErrKeyNotFound)
+
+	setEnvironment("CONFIGO_STRICT_UPPER", "upper")
+	values, err = this.source.Strings("strict-upper")
+	this.So(values, should.Resemble, []string{"upper"})
+	this.So(err, should.BeNil)
+}
+
+func (this *EnvironmentSourceFixture) TestExactCaseOnly() {
+	this.source = FromCustomEnvironment(EnvironmentPrefix("configo_"), EnvironmentExactCase())
+
+	this.So(this.source.VariableNames("Mixed-Case"), should.Resemble, []string{"configo_Mixed_Case"})
+}
+
+func (this *EnvironmentSourceFixture) TestDefaultVariableNames() {
+	this.So(this.source.VariableNames("env:some-key"), should.Resemble, []string{"configo_some_key", "CONFIGO_SOME_KEY"})
+}
+
+func (this *EnvironmentSourceFixture) TestEscapedSeparator() {
+	this.source = FromCustomEnvironment(EnvironmentPrefix("configo_"), EnvironmentEscaping())
+	setEnvironment("configo_escaped", `https://host/a\|b|pa\\ss|"quoted|value"|\"`)
+
+	values, err := this.source.Strings("escaped")
+
+	this.So(values, should.Resemble, []string{"https://host/a|b", `pa\ss`, "quoted|value", `"`})
+	this.So(err, should.BeNil)
+}
+
+func (this *EnvironmentSourceFixture) TestBackslashKeptBeforeOtherCharacters() {
+	this.source = FromCustomEnvironment(EnvironmentPrefix("configo_"), EnvironmentEscaping())
+	setEnvironment("configo_backslashes", `C:\dir|^\d+$|trailing\`)
+
+	values, err := this.source.Strings("backslashes")
+
+	this.So(values, should.Resemble, []string{`C:\dir`, `^\d+$`, `trailing\`})
+	this.So(err, should.BeNil)
+}
+
+func (this *EnvironmentSourceFixture) TestUnterminatedQuote() {
+	this.source = FromCustomEnvironment(EnvironmentPrefix("configo_"), EnvironmentEscaping())
+	setEnvironment("configo_unterminated", `a|"b|c`)
+
+	values, err := this.source.Strings("unterminated")
+
+	this.So(values, should.BeNil)
+	this.So(err, should.Wrap, ErrMalformedValue)
+
+	reader := NewReader(this.source, NewDefaultSource(Default("unterminated", "fallback")))
+	_, err = reader.StringsError("unterminated")
+	this.So(err, should.Wrap, ErrMalformedValue)
+	this.So(err.Error(), should.Equal,
+		`the specified value could not be parsed: key "unterminated" from EnvironmentSource has value "a|\"b|c": unterminated double quote`)
+}
+
+func (this *EnvironmentSourceFixture) TestUnterminatedQuoteWithinMultiSource() {
+	this.source = FromCustomEnvironment(EnvironmentPrefix("configo_"), EnvironmentEscaping())
+	setEnvironment("configo_unterminated", `a|"b|c`)
+	multi := MultiSource{this.source, NewDefaultSource(Default("unterminated", "fallback"))}
+
+	values, err := multi.Strings("unterminated")
+	this.So(values, should.BeNil)
+	this.So(err, should.Wrap, ErrMalformedValue)
+
+	_, err = NewReader(multi).StringsError("unterminated")
+	this.So(err, should.Wrap, ErrMalformedValue)
+	this.So(err.Error(), should.ContainSubstring, `key "unterminated" from EnvironmentSource has value`)
+}
+
+func (this *EnvironmentSourceFixture) TestSeparatorNotEscapedByDefault() {
+	setEnvironment("configo_unescaped", `a\,b`)
+
+	values, _ := this.source.Strings("unescaped")
+
+	this.So(values, should.Resemble, []string{`a\`, "b"})
+}
+
+func (this *EnvironmentSourceFixture) TestJSONArray() {
+	this.source = FromCustomEnvironment(EnvironmentPrefix("configo_"), EnvironmentJSONArrays())
+	setEnvironment("configo_json_array", `["a|b", "c", 1, true]`)
+	setEnvironment("configo_not_json_array", `[a|b`)
+
+	values, _ := this.source.Strings("json-array")
+	this.So(values, should.Resemble, []string{"a|b", "c", "1", "true"})
+
+	values, _ = this.source.Strings("not-json-array")
+	this.So(values, should.Resemble, []string{"[a", "b"})
+}
+
+func (this *EnvironmentSourceFixture) TestExplicitVariableList() {
+	this.source = FromEnvironmentList([]string{"CONFIGO_LIST=a|b", "CONFIGO_EQUALS=x=y", "malformed"}, EnvironmentPrefix("configo_"))
+
+	values, _ := this.source.Strings("list")
+	this.So(values, should.Resemble, []string{"a", "b"})
+
+	values, _ = this.source.Strings("equals")
+	this.So(values, should.Resemble, []string{"x=y"})
+
+	setEnvironment("configo_process_only", "value")
+	values, err := this.source.Strings("process-only")
+	this.So(values, should.BeNil)
+	this.So(err, should.Equal, ErrKeyNotFound)
+}
+
+func (this *EnvironmentSourceFixture) TestExplicitVariableMap() {
+	variables := map[string]string{"configo_map": "value"}
+	this.source = FromEnvironmentMap(variables, EnvironmentPrefix("configo_"))
+	variables["configo_map"] = "changed"
+
+	values, _ := this.source.Strings("map")
+
+	this.So(values, should.Resemble, []string{"value"})
+}
+
+func (this *EnvironmentSourceFixture) TestSnapshotTakenAtInitialize() {
+	setEnvironment("configo_snapshot", "before")
+	this.source = FromCustomEnvironment(EnvironmentPrefix("configo_"), EnvironmentSnapshot())
+	this.source.Initialize()
+	setEnvironment("configo_snapshot", "after")
+
+	values, _ := this.source.Strings("snapshot")
+
+	this.So(values, should.Resemble, []string{"before"})
+}
+
 func setEnvironment(key, value string) {
 	os.Setenv(key, value)
 }
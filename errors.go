@@ -1,8 +1,108 @@
 package configo
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
 
 var (
 	ErrKeyNotFound    = errors.New("the specified key was not found")
 	ErrMalformedValue = errors.New("the specified value could not be parsed")
+
+	ErrUnregisteredType = errors.New("no parser has been registered for the specified type")
 )
+
+// KeyError is returned by a Reader when a key (and each of its aliases) was not found in any source.
+// It is compatible with ErrKeyNotFound (according to errors.Is).
+type KeyError struct {
+	Key     string
+	Aliases []string
+}
+
+func (this *KeyError) Error() string {
+	message := fmt.Sprintf("%s: %q", ErrKeyNotFound, this.Key)
+	if len(this.Aliases) > 0 {
+		message += fmt.Sprintf(" (or aliases: %s)", quoteAll(this.Aliases))
+	}
+	return message
+}
+
+// Unwrap returns ErrKeyNotFound.
+func (this *KeyError) Unwrap() error { return ErrKeyNotFound }
+
+// ParseError is returned by a Reader when the raw value of a key could not be parsed as the requested type.
+// It is compatible with ErrMalformedValue (according to errors.Is) and unwraps to the underlying error
+// (ex: a *strconv.NumError), if any.
+type ParseError struct {
+	Key     string
+	Aliases []string
+	Source  string
+	Value   string
+	Err     error
+}
+
+func (this *ParseError) Error() string {
+	message := fmt.Sprintf("%s: value %q", ErrMalformedValue, this.Value)
+	if len(this.Key) > 0 {
+		message = fmt.Sprintf("%s: key %q", ErrMalformedValue, this.Key)
+		if len(this.Source) > 0 {
+			message += " from " + this.Source
+		}
+		message += fmt.Sprintf(" has value %q", this.Value)
+	}
+	if this.Err != nil {
+		message += ": " + this.Err.Error()
+	}
+	return message
+}
+
+// Is reports whether the target is ErrMalformedValue.
+func (this *ParseError) Is(target error) bool { return target == ErrMalformedValue }
+
+// Unwrap returns the underlying error, if any.
+func (this *ParseError) Unwrap() error { return this.Err }
+
+// ValidationError is returned by Requirements.Validate. It lists every key which was missing or
+// malformed along with the corresponding error (usually a *KeyError or *ParseError).
+type ValidationError struct {
+	Keys   []string
+	Errors []error
+}
+
+func (this *ValidationError) Error() string {
+	message := fmt.Sprintf("%d configuration key(s) missing or malformed:", len(this.Errors))
+	for _, err := range this.Errors {
+		message += "\n  - " + err.Error()
+	}
+	return message
+}
+
+// Is reports whether any of the errors matches the target (according to errors.Is).
+func (this *ValidationError) Is(target error) bool {
+	for _, err := range this.Errors {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// As finds the first of the errors which matches the target (according to errors.As), and if one
+// is found, sets the target to that error value and returns true.
+func (this *ValidationError) As(target interface{}) bool {
+	for _, err := range this.Errors {
+		if errors.As(err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+func quoteAll(values []string) string {
+	quoted := make([]string, len(values))
+	for i, value := range values {
+		quoted[i] = fmt.Sprintf("%q", value)
+	}
+	return strings.Join(quoted, ", ")
+}
@@ -0,0 +1,140 @@
+package configo
+
+import (
+	"errors"
+	"io/fs"
+	"strconv"
+	"testing"
+
+	"github.com/smartystreets/assertions/should"
+	"github.com/smartystreets/gunit"
+)
+
+func TestErrorsFixture(t *testing.T) {
+	gunit.Run(new(ErrorsFixture), t)
+}
+
+type ErrorsFixture struct {
+	*gunit.Fixture
+
+	reader *Reader
+}
+
+func (this *ErrorsFixture) Setup() {
+	this.reader = NewReader(
+		FromJSONContent([]byte(`{"ports": ["80", "80x"], "labels": {"a": 1, "a": 2}}`)),
+		NewDefaultSource(
+			Default("port", "80x"),
+			Default("config", "/configo/missing.json"),
+		),
+	)
+}
+
+func (this *ErrorsFixture) TestKeyError() {
+	this.reader.RegisterAlias("listen", "address")
+
+	_, err := this.reader.IntError("listen")
+
+	var keyError *KeyError
+	this.So(errors.As(err, &keyError), should.BeTrue)
+	this.So(keyError.Key, should.Equal, "listen")
+	this.So(keyError.Aliases, should.Resemble, []string{"address"})
+	this.So(err, should.Wrap, ErrKeyNotFound)
+	this.So(err.Error(), should.Equal, `the specified key was not found: "listen" (or aliases: "address")`)
+}
+
+func (this *ErrorsFixture) TestAliasesAreCopied() {
+	this.reader.RegisterAlias("listen", "address")
+
+	_, err := this.reader.IntError("listen")
+	err.(*KeyError).Aliases[0] = "changed"
+	_, err = this.reader.IntError("port")
+	this.parseError(err).Aliases = append(this.parseError(err).Aliases, "changed")
+
+	_, err = this.reader.IntError("listen")
+	this.So(err.(*KeyError).Aliases, should.Resemble, []string{"address"})
+	this.So(this.reader.resolvePossibleKeys("listen"), should.Resemble, []string{"listen", "address"})
+}
+
+func (this *ErrorsFixture) TestParseErrorNamesFileOfSource() {
+	directory := newTempDirectory("errors")
+	defer directory.remove()
+	filename := directory.write("app.json", []byte(`{"port": "80x"}`))
+	reader := NewReader(FromJSONFile(filename))
+	reader.RegisterAlias("port", "listen-port")
+
+	_, err := reader.IntError("listen-port")
+
+	this.So(this.parseError(err).Source, should.Equal, `JSONSource "`+filename+`"`)
+}
+
+func (this *ErrorsFixture) TestParseError() {
+	_, err := this.reader.IntError("port")
+
+	parseError := this.parseError(err)
+	this.So(parseError.Key, should.Equal, "port")
+	this.So(parseError.Source, should.Equal, "DefaultSource")
+	this.So(parseError.Value, should.Equal, "80x")
+	this.So(err, should.Wrap, ErrMalformedValue)
+	this.So(err, should.Wrap, strconv.ErrSyntax)
+	this.So(err.Error(), should.Equal,
+		`the specified value could not be parsed: key "port" from DefaultSource has value "80x": strconv.Atoi: parsing "80x": invalid syntax`)
+}
+
+func (this *ErrorsFixture) TestParseErrorOfAlias() {
+	this.reader.RegisterAlias("port", "listen-port")
+
+	_, err := this.reader.Uint16Error("listen-port")
+
+	parseError := this.parseError(err)
+	this.So(parseError.Key, should.Equal, "listen-port")
+	this.So(parseError.Aliases, should.Resemble, []string{"port"})
+	this.So(parseError.Value, should.Equal, "80x")
+}
+
+func (this *ErrorsFixture) TestParseErrorIdentifiesMalformedValueAmongMany() {
+	_, err := this.reader.IntsError("ports")
+
+	parseError := this.parseError(err)
+	this.So(parseError.Source, should.Equal, "JSONSource")
+	this.So(parseError.Value, should.Equal, "80x")
+}
+
+func (this *ErrorsFixture) TestParseErrorWithoutUnderlyingError() {
+	_, err := this.reader.ByteSizeError("port")
+
+	parseError := this.parseError(err)
+	this.So(parseError.Err, should.BeNil)
+	this.So(err.Error(), should.Equal, `the specified value could not be parsed: key "port" from DefaultSource has value "80x"`)
+}
+
+func (this *ErrorsFixture) TestParseErrorFromRegisteredParser() {
+	RegisterParser(parseGenericColor)
+
+	_, err := GetError[genericColor](this.reader, "port")
+
+	parseError := this.parseError(err)
+	this.So(parseError.Key, should.Equal, "port")
+	this.So(parseError.Err.Error(), should.Equal, "unknown color")
+}
+
+func (this *ErrorsFixture) TestParseErrorFromPath() {
+	_, err := this.reader.PathError("config", PathMustExist())
+
+	this.So(this.parseError(err).Value, should.Equal, "/configo/missing.json")
+	this.So(err, should.Wrap, fs.ErrNotExist)
+}
+
+func (this *ErrorsFixture) TestParseErrorFromMap() {
+	_, err := this.reader.StringMapError("labels")
+
+	parseError := this.parseError(err)
+	this.So(parseError.Source, should.Equal, "JSONSource")
+	this.So(parseError.Err.Error(), should.Equal, `duplicate key "a"`)
+}
+
+func (this *ErrorsFixture) parseError(err error) *ParseError {
+	var parseError *ParseError
+	this.So(errors.As(err, &parseError), should.BeTrue)
+	return parseError
+}
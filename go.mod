@@ -1,6 +1,6 @@
 module github.com/smartystreets/configo/v2
 
-go 1.13
+go 1.18
 
 require (
 	github.com/smartystreets/assertions v1.2.0
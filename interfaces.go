@@ -7,3 +7,18 @@ type Source interface {
 	Initialize()
 	Strings(key string) ([]string, error)
 }
+
+// MapSource may be implemented by a Source whose values are hierarchical (such as nested JSON objects).
+// The StringMap method returns the keys and values of the object associated with the given key with
+// ErrKeyNotFound if the key does not exist or isn't an object, or an error compatible with ErrMalformedValue
+// (such as a *ParseError) if the object contains duplicate keys.
+type MapSource interface {
+	StringMap(key string) (map[string]string, error)
+}
+
+// FileSource may be implemented by a Source whose values are read from files. The Filename method
+// returns the path of the file which provides the value of the given key (or an empty string), so that
+// relative paths can be resolved against its directory (see Reader.Path).
+type FileSource interface {
+	Filename(key string) string
+}
@@ -1,8 +1,10 @@
 package configo
 
 import (
+	"bytes"
 	"encoding/json"
-	"flag"
+	"fmt"
+	"io/fs"
 	"io/ioutil"
 	"os"
 	"strconv"
@@ -10,16 +12,38 @@ import (
 
 // JSONSource houses key-value pairs unmarshaled from JSON data.
 type JSONSource struct {
-	values map[string]interface{}
+	filename string
+	values   map[string]interface{}
+	raw      map[string]json.RawMessage
 }
 
 // FromConfigurableJSONFile allows the user to configure the config file path
 // via the -config command line flag.
 func FromConfigurableJSONFile() *JSONSource {
-	flags := flag.NewFlagSet("config-file", flag.ContinueOnError)
-	filename := flags.String("config", "config.json", "The path to the JSON config file.")
-	flags.Parse(os.Args[1:]) // don't include the command name (argument #0).
-	return FromJSONFile(*filename)
+	return FromConfigurableJSONFileArguments(os.Args[1:]) // don't include the command name (argument #0).
+}
+
+// FromConfigurableJSONFileArguments is like FromConfigurableJSONFile but parses the provided arguments
+// rather than os.Args. Just like a *flag.FlagSet, parsing stops at the first flag other than -config,
+// so a command line with other flags should be parsed by a shared CLISource (see FromCLISourceJSONFile).
+func FromConfigurableJSONFileArguments(arguments []string) *JSONSource {
+	return FromCLISourceJSONFile(FromCLIArguments(arguments, SetOutput(ioutil.Discard)))
+}
+
+// FromCLISourceJSONFile registers the -config flag on the provided CLISource (unless it is already
+// registered) and loads the JSON file it names ("config.json" by default). The command line is parsed
+// once by the shared CLISource (see Parse), so the two sources can't disagree about it.
+func FromCLISourceJSONFile(commandLine *CLISource) *JSONSource {
+	if _, found := commandLine.registry["config"]; !found {
+		Flag("config", "The path to the JSON config file.")(commandLine)
+	}
+
+	filename := "config.json"
+	commandLine.Initialize()
+	if values, err := commandLine.Strings("config"); err == nil {
+		filename = values[len(values)-1]
+	}
+	return FromJSONFile(filename)
 }
 
 // FromJSONFile reads and unmarshals the file at the provided path into a JSONSource.
@@ -28,7 +52,9 @@ func FromJSONFile(filename string) *JSONSource {
 	if contents, err := ioutil.ReadFile(filename); err != nil {
 		panic(err)
 	} else {
-		return FromJSONContent(contents)
+		source := FromJSONContent(contents)
+		source.filename = filename
+		return source
 	}
 }
 
@@ -45,6 +71,36 @@ func FromConditionalJSONFile(filename string, condition func() bool) *JSONSource
 // FromOptionalJSONFile is like FromJSONFile but it does not panic if the file is not found.
 func FromOptionalJSONFile(filename string) *JSONSource {
 	if contents, _ := ioutil.ReadFile(filename); len(contents) > 0 {
+		source := FromJSONContent(contents)
+		source.filename = filename
+		return source
+	}
+
+	return nil
+}
+
+// FromJSONFileFS reads and unmarshals the named file from the provided fs.FS (such as an embed.FS)
+// into a JSONSource. Any resulting error results in a panic.
+func FromJSONFileFS(fsys fs.FS, filename string) *JSONSource {
+	if contents, err := fs.ReadFile(fsys, filename); err != nil {
+		panic(err)
+	} else {
+		return FromJSONContent(contents)
+	}
+}
+
+// FromConditionalJSONFileFS is like FromConditionalJSONFile but reads the file from the provided fs.FS.
+func FromConditionalJSONFileFS(fsys fs.FS, filename string, condition func() bool) *JSONSource {
+	if condition() {
+		return FromJSONFileFS(fsys, filename)
+	}
+
+	return FromOptionalJSONFileFS(fsys, filename)
+}
+
+// FromOptionalJSONFileFS is like FromJSONFileFS but it does not panic if the file is not found.
+func FromOptionalJSONFileFS(fsys fs.FS, filename string) *JSONSource {
+	if contents, _ := fs.ReadFile(fsys, filename); len(contents) > 0 {
 		return FromJSONContent(contents)
 	}
 
@@ -59,7 +115,9 @@ func FromJSONContent(raw []byte) *JSONSource {
 		panic("json error: " + err.Error())
 	}
 
-	return FromJSONObject(values)
+	source := FromJSONObject(values)
+	_ = json.Unmarshal(raw, &source.raw) // retained to detect duplicate keys in nested objects (see StringMap)
+	return source
 }
 
 func FromJSONObject(values map[string]interface{}) *JSONSource {
@@ -94,4 +152,70 @@ func toStrings(value interface{}) (values []string) {
 	}
 }
 
+// StringMap returns the keys and values of the nested object associated with the given key, or ErrKeyNotFound
+// if the key does not exist or its value isn't an object. Nested values which are themselves arrays or
+// objects are returned as JSON. Duplicate keys within the nested object result in a *ParseError.
+func (this *JSONSource) StringMap(key string) (map[string]string, error) {
+	if raw, found := this.raw[key]; found {
+		return parseJSONObject(raw)
+	}
+
+	object, found := this.values[key].(map[string]interface{})
+	if !found {
+		return nil, ErrKeyNotFound
+	}
+
+	values := make(map[string]string, len(object))
+	for name, value := range object {
+		values[name] = jsonString(value)
+	}
+	return values, nil
+}
+func parseJSONObject(raw json.RawMessage) (map[string]string, error) {
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	if token, err := decoder.Token(); err != nil || token != json.Delim('{') {
+		return nil, ErrKeyNotFound
+	}
+
+	values := make(map[string]string)
+	for decoder.More() {
+		token, err := decoder.Token()
+		if err != nil {
+			return nil, &ParseError{Value: string(raw), Err: err}
+		}
+
+		name := token.(string)
+		if _, duplicate := values[name]; duplicate {
+			return nil, &ParseError{Value: string(raw), Err: fmt.Errorf("duplicate key %q", name)}
+		}
+
+		var value interface{}
+		if err := decoder.Decode(&value); err != nil {
+			return nil, &ParseError{Value: string(raw), Err: err}
+		}
+		values[name] = jsonString(value)
+	}
+	return values, nil
+}
+func jsonString(value interface{}) string {
+	switch value.(type) {
+	case nil:
+		return ""
+	case []interface{}, map[string]interface{}:
+		encoded, _ := json.Marshal(value)
+		return string(encoded)
+	default:
+		return toStrings(value)[0]
+	}
+}
+
+// Filename returns the path of the file from which the source was read (see FileSource),
+// or an empty string if the key does not exist or the source wasn't read from a file.
+func (this *JSONSource) Filename(key string) string {
+	if _, found := this.values[key]; found {
+		return this.filename
+	}
+	return ""
+}
+
 func (this *JSONSource) Initialize() {}
@@ -2,6 +2,7 @@ package configo
 
 import (
 	"testing"
+	"testing/fstest"
 
 	"github.com/smartystreets/assertions/should"
 	"github.com/smartystreets/gunit"
@@ -57,6 +58,52 @@ func (this *JSONSourceFixture) TestReadMultipleValues() {
 	this.assertSuccess(`{"key":["value", 1, 1.2, true]}`, "key", "value", "1", "1.2", "true")
 }
 
+func (this *JSONSourceFixture) TestReadFromFS() {
+	fsys := fstest.MapFS{"config/app.json": {Data: []byte(`{"key":"value"}`)}}
+	source := FromJSONFileFS(fsys, "config/app.json")
+
+	values, err := source.Strings("key")
+
+	this.So(values, should.Resemble, []string{"value"})
+	this.So(err, should.BeNil)
+}
+func (this *JSONSourceFixture) TestMissingFileFromFSPanics() {
+	this.So(func() { FromJSONFileFS(fstest.MapFS{}, "missing.json") }, should.Panic)
+}
+func (this *JSONSourceFixture) TestMissingOptionalFileFromFSIsNil() {
+	this.So(FromOptionalJSONFileFS(fstest.MapFS{}, "missing.json"), should.BeNil)
+}
+
+func (this *JSONSourceFixture) TestConfigurableJSONFileFromArguments() {
+	directory := newTempDirectory("configurable-json")
+	defer directory.remove()
+	filename := directory.write("app.json", []byte(`{"key":"value"}`))
+
+	for _, arguments := range [][]string{
+		{"-config", filename},
+		{"--config=" + filename},
+		{"-config=ignored.json", "-config", filename, "remaining", "-config=ignored.json"},
+	} {
+		source := FromConfigurableJSONFileArguments(arguments)
+		this.So(source.filename, should.Equal, filename)
+	}
+
+	// parsing stops at the unknown flag, so the (missing) default config.json is loaded.
+	this.So(func() { FromConfigurableJSONFileArguments([]string{"-unknown", "-config", filename}) }, should.Panic)
+}
+
+func (this *JSONSourceFixture) TestConfigurableJSONFileSharesCommandLine() {
+	directory := newTempDirectory("configurable-json")
+	defer directory.remove()
+	filename := directory.write("app.json", []byte(`{"key":"value"}`))
+
+	commandLine := FromCLIArguments([]string{"-name", "-config", "-config", filename}, Flag("name", "The name"))
+	source := FromCLISourceJSONFile(commandLine)
+
+	this.So(source.filename, should.Equal, filename)
+	this.So(NewReader(commandLine).String("name"), should.Equal, "-config")
+}
+
 func (this *JSONSourceFixture) assertSuccess(raw, key string, expectedValues ...string) {
 	source := FromJSONContent([]byte(raw))
 
@@ -1,5 +1,7 @@
 package configo
 
+import "errors"
+
 type MultiSource []Source
 
 func (this MultiSource) Initialize() {
@@ -8,12 +10,40 @@ func (this MultiSource) Initialize() {
 	}
 }
 
+// Strings returns the values of the first source which provides the key. Just like a Reader,
+// a malformed value (see ErrMalformedValue) isn't overridden by the sources that follow.
 func (this MultiSource) Strings(key string) (result []string, err error) {
 	for _, source := range this {
 		result, err = source.Strings(key)
-		if err == nil {
+		if err == nil || errors.Is(err, ErrMalformedValue) {
 			return result, err
 		}
 	}
 	return nil, ErrKeyNotFound
 }
+
+// Filename returns the path of the file (see FileSource) of the first source which provides the key.
+func (this MultiSource) Filename(key string) string {
+	for _, source := range this {
+		if _, err := source.Strings(key); err == nil {
+			if file, ok := source.(FileSource); ok {
+				return file.Filename(key)
+			}
+			return ""
+		}
+	}
+	return ""
+}
+
+// StringMap returns the object associated with the key by the first source which implements MapSource
+// and has an object for the key (see MapSource).
+func (this MultiSource) StringMap(key string) (map[string]string, error) {
+	for _, source := range this {
+		if hierarchical, ok := source.(MapSource); ok {
+			if values, err := hierarchical.StringMap(key); !errors.Is(err, ErrKeyNotFound) {
+				return values, err
+			}
+		}
+	}
+	return nil, ErrKeyNotFound
+}
@@ -1,6 +1,8 @@
 package configo
 
 import (
+	"errors"
+	"fmt"
 	"log"
 	"net/url"
 	"reflect"
@@ -12,9 +14,10 @@ import (
 // Reader retrieves values from the provided sources, handling conversions
 // to the type identified by the method being called (Strings, Ints, etc...).
 type Reader struct {
-	sources []Source
-	aliases map[string][]string
-	fatal   func(string, error)
+	sources  []Source
+	aliases  map[string][]string
+	fatal    func(string, error)
+	location *time.Location
 }
 
 // NewReader initializes a new reader using the provided sources. It calls each
@@ -61,32 +64,147 @@ func (this *Reader) Strings(key string) []string {
 // if the key does not exist. It does so by searching it sources, in the order
 // they were provided, and returns the first non-error result or ErrKeyNotFound.
 func (this *Reader) StringsError(key string) ([]string, error) {
+	values, _, err := this.locate(key)
+	return values, err
+}
+
+// locate returns the values associated with the given key (or any of its aliases)
+// along with the source that provided them. A source which reports a malformed value
+// (ex: an EnvironmentSource with an unterminated quote) results in a *ParseError.
+func (this *Reader) locate(key string) ([]string, Source, error) {
+	values, source, _, err := this.locateAlias(key)
+	return values, source, err
+}
+
+// locateAlias is like locate but also returns the key (or alias) under which the source supplied the values.
+func (this *Reader) locateAlias(key string) ([]string, Source, string, error) {
 	for _, alias := range this.resolvePossibleKeys(key) {
-		if values, err := this.stringsError(alias); err == nil {
-			return values, nil
+		if values, source, err := this.stringsError(alias); err == nil {
+			return values, source, alias, nil
+		} else if errors.Is(err, ErrMalformedValue) {
+			return nil, source, alias, this.parseError(key, source, "", err)
 		}
 	}
 
-	return nil, ErrKeyNotFound
+	return nil, nil, "", &KeyError{Key: key, Aliases: this.aliasesOf(key)}
 }
-func (this *Reader) stringsError(key string) ([]string, error) {
+func (this *Reader) stringsError(key string) ([]string, Source, error) {
 	for _, source := range this.sources {
 		if value, err := source.Strings(key); err == nil {
 			if len(value) > 0 && strings.HasPrefix(value[0], "env:") {
 				key = value[0] // if an EnvironmentSource is still to be inspected, it will remove the 'env:' prefix and do the lookup.
 				continue
 			}
-			return value, nil
+			return value, supplier(source, key), nil
+		} else if errors.Is(err, ErrMalformedValue) {
+			return nil, supplier(source, key), err // a malformed value isn't overridden by the sources that follow.
 		}
 	}
 
-	return nil, ErrKeyNotFound
+	return nil, nil, ErrKeyNotFound
+}
+
+// supplier returns the source which actually supplies the key, which may be nested within a MultiSource.
+func supplier(source Source, key string) Source {
+	multi, ok := source.(MultiSource)
+	if !ok {
+		return source
+	}
+
+	for _, inner := range multi {
+		if _, err := inner.Strings(key); err == nil || errors.Is(err, ErrMalformedValue) {
+			return supplier(inner, key)
+		}
+	}
+	return source
 }
 
 func (this *Reader) resolvePossibleKeys(key string) []string {
 	return append([]string{key}, this.aliases[key]...)
 }
 
+// parseAll parses all values associated with the given key, reporting any error
+// as a *ParseError (or the *KeyError returned by locate).
+func parseAll[T any](reader *Reader, key string, parse func(string) (T, error)) ([]T, error) {
+	raw, source, err := reader.locate(key)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make([]T, len(raw))
+	for i, r := range raw {
+		if values[i], err = parse(r); err != nil {
+			return nil, reader.parseError(key, source, r, err)
+		}
+	}
+
+	return values, nil
+}
+
+// parseFirst parses the first value associated with the given key (or an empty string if there are no values),
+// reporting any error as a *ParseError (or the *KeyError returned by locate).
+func parseFirst[T any](reader *Reader, key string, parse func(string) (T, error)) (T, error) {
+	var zero T
+	raw, source, err := reader.locate(key)
+	if err != nil {
+		return zero, err
+	}
+
+	first := ""
+	if len(raw) > 0 {
+		first = raw[0]
+	}
+
+	value, err := parse(first)
+	if err != nil {
+		return zero, reader.parseError(key, source, first, err)
+	}
+
+	return value, nil
+}
+
+// parseError adds the context of the key, its aliases and the source of the raw value to the error
+// returned by a parse function, which may be a (partially completed) *ParseError, ErrMalformedValue,
+// or the underlying error (ex: from strconv).
+func (this *Reader) parseError(key string, source Source, raw string, err error) error {
+	var parsed *ParseError
+	if !errors.As(err, &parsed) {
+		parsed = &ParseError{Value: raw, Err: err}
+		if err == ErrMalformedValue {
+			parsed.Err = nil
+		}
+	}
+
+	parsed.Key = key
+	parsed.Aliases = this.aliasesOf(key)
+	if source != nil {
+		parsed.Source = this.sourceName(source, key)
+	}
+	return parsed
+}
+
+// sourceName names the type of the source along with the file from which it read the key (or one of
+// its aliases), if any (see FileSource).
+func (this *Reader) sourceName(source Source, key string) string {
+	name := strings.TrimPrefix(strings.TrimPrefix(fmt.Sprintf("%T", source), "*"), "configo.")
+	if file, ok := source.(FileSource); ok {
+		for _, alias := range this.resolvePossibleKeys(key) {
+			if filename := file.Filename(alias); len(filename) > 0 {
+				return fmt.Sprintf("%s %q", name, filename)
+			}
+		}
+	}
+	return name
+}
+
+// aliasesOf returns a copy of the aliases of the key (see RegisterAlias) which can be safely handed to callers.
+func (this *Reader) aliasesOf(key string) []string {
+	if len(this.aliases[key]) == 0 {
+		return nil
+	}
+	return append([]string(nil), this.aliases[key]...)
+}
+
 // StringsPanic returns all values associated with the given key or panics
 // if the key does not exist.
 func (this *Reader) StringsPanic(key string) []string {
@@ -185,20 +303,7 @@ func (this *Reader) Ints(key string) []int {
 // IntsError returns all integer values associated with the given key with an error
 // if the key does not exist or the values could not be parsed as integers.
 func (this *Reader) IntsError(key string) ([]int, error) {
-	raw, err := this.StringsError(key)
-	if err != nil {
-		return nil, err
-	}
-
-	ints := make([]int, len(raw))
-	for i, r := range raw {
-		ints[i], err = strconv.Atoi(r)
-		if err != nil {
-			return nil, ErrMalformedValue
-		}
-	}
-
-	return ints, nil
+	return parseAll(this, key, strconv.Atoi)
 }
 
 // IntsPanic returns all integer values associated with the given key or panics
@@ -244,17 +349,7 @@ func (this *Reader) Int(key string) int {
 // IntError returns the first integer value associated with the given key with an error
 // if the key does not exist or the values could not be parsed as integers (according to strconv.Atoi).
 func (this *Reader) IntError(key string) (int, error) {
-	raw, err := this.StringError(key)
-	if err != nil {
-		return 0, err
-	}
-
-	number, err := strconv.Atoi(raw)
-	if err != nil {
-		return 0, ErrMalformedValue
-	}
-
-	return number, nil
+	return parseFirst(this, key, strconv.Atoi)
 }
 
 // IntPanic returns the first integer value associated with the given key or panics
@@ -300,17 +395,7 @@ func (this *Reader) Bool(key string) bool {
 // BoolError returns the boolean value associated with the given key with an error
 // if the key does not exist or the value could not be parsed as a bool (according to strconv.ParseBool).
 func (this *Reader) BoolError(key string) (bool, error) {
-	raw, err := this.StringError(key)
-	if err != nil {
-		return false, err
-	}
-
-	value, err := strconv.ParseBool(raw)
-	if err != nil {
-		return false, ErrMalformedValue
-	}
-
-	return value, nil
+	return parseFirst(this, key, strconv.ParseBool)
 }
 
 // BoolPanic returns the boolean value associated with the given key or panics
@@ -356,21 +441,7 @@ func (this *Reader) URLs(key string) []url.URL {
 // URLsError returns all URL values associated with the given key with an error
 // if the key does not exist or the values could not be parsed as URLs.
 func (this *Reader) URLsError(key string) ([]url.URL, error) {
-	raw, err := this.StringsError(key)
-	if err != nil {
-		return nil, err
-	}
-
-	urls := make([]url.URL, len(raw))
-	for i, r := range raw {
-		parsed, err := url.Parse(r)
-		if err != nil {
-			return nil, ErrMalformedValue
-		}
-		urls[i] = *parsed
-	}
-
-	return urls, nil
+	return parseAll(this, key, parseURL)
 }
 
 // URLsPanic returns all URL values associated with the given key or panics
@@ -416,17 +487,7 @@ func (this *Reader) URL(key string) url.URL {
 // URLError returns the first URL associated with the given key with an error
 // if the key does not exist or the values could not be parsed as URLs.
 func (this *Reader) URLError(key string) (url.URL, error) {
-	raw, err := this.StringError(key)
-	if err != nil {
-		return url.URL{}, err
-	}
-
-	parsed, err := url.Parse(raw)
-	if err != nil {
-		return url.URL{}, ErrMalformedValue
-	}
-
-	return *parsed, nil
+	return parseFirst(this, key, parseURL)
 }
 
 // URLPanic returns the first URL associated with the given key or panics
@@ -473,17 +534,7 @@ func (this *Reader) Duration(key string) time.Duration {
 // DurationError returns the first Duration associated with the given key with an error
 // if the key does not exist or the values could not be parsed as Durations.
 func (this *Reader) DurationError(key string) (time.Duration, error) {
-	raw, err := this.StringError(key)
-	if err != nil {
-		return 0, err
-	}
-
-	parsed, err := time.ParseDuration(raw)
-	if err != nil {
-		return 0, ErrMalformedValue
-	}
-
-	return parsed, nil
+	return parseFirst(this, key, time.ParseDuration)
 }
 
 // DurationPanic returns the first Duration associated with the given key or panics
@@ -522,6 +573,8 @@ func (this *Reader) DurationDefault(key string, Default time.Duration) time.Dura
 // Time returns the first Time associated with the given key or returns the zero value
 // if the key does not exist or the value could not be parsed as a Time using the provided format.
 // For examples of format strings see http://golang.org/pkg/time/#pkg-constants
+// The format may also be AutoTimeFormat, UnixTimeFormat, UnixMilliTimeFormat, or several
+// formats combined by TimeFormats.
 func (this *Reader) Time(key string, format string) time.Time {
 	parsed, _ := this.TimeError(key, format)
 	return parsed
@@ -530,17 +583,7 @@ func (this *Reader) Time(key string, format string) time.Time {
 // TimeError returns the first Time associated with the given key with an error
 // if the key does not exist or the values could not be parsed as Times using the provided format.
 func (this *Reader) TimeError(key string, format string) (time.Time, error) {
-	raw, err := this.StringError(key)
-	if err != nil {
-		return time.Time{}, err
-	}
-
-	parsed, err := time.Parse(format, raw)
-	if err != nil {
-		return time.Time{}, ErrMalformedValue
-	}
-
-	return parsed, nil
+	return parseFirst(this, key, func(raw string) (time.Time, error) { return parseTime(raw, format, this.location) })
 }
 
 // TimePanic returns the first Time associated with the given key or panics
@@ -575,3 +618,11 @@ func (this *Reader) TimeDefault(key string, format string, Default time.Time) ti
 }
 
 /* ////////////////////////////////////////////////////////////////////////////////////////////////////////////////// */
+
+func parseURL(raw string) (url.URL, error) {
+	parsed, err := url.Parse(raw)
+	if err != nil {
+		return url.URL{}, err
+	}
+	return *parsed, nil
+}
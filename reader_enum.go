@@ -0,0 +1,186 @@
+package configo
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Enum constrains a value to a fixed set of allowed values (ex: the levels of a logger).
+// See Reader.Enum and RegisterEnum.
+type Enum struct {
+	values      []string
+	aliases     []enumAlias
+	insensitive bool
+}
+
+type enumAlias struct {
+	alias string
+	value string
+}
+
+// EnumOption configures an Enum.
+type EnumOption func(*Enum)
+
+// NewEnum creates an Enum which allows only the provided values (and any aliases registered with EnumAlias).
+// It panics if an alias refers to a value which isn't allowed.
+func NewEnum(values []string, options ...EnumOption) *Enum {
+	enum := &Enum{values: values}
+	for _, option := range options {
+		option(enum)
+	}
+	for i, alias := range enum.aliases {
+		value, found := enum.value(alias.value)
+		if !found {
+			panic(fmt.Sprintf("enum alias %q refers to %q, which is not one of: %s", alias.alias, alias.value, strings.Join(values, ", ")))
+		}
+		enum.aliases[i].value = value
+	}
+	return enum
+}
+
+// EnumCaseInsensitive allows values (and aliases) to be supplied in any case. Parse always
+// returns the value with the casing provided to NewEnum.
+func EnumCaseInsensitive() EnumOption {
+	return func(this *Enum) { this.insensitive = true }
+}
+
+// EnumAlias allows the alias to be supplied in place of the value (ex: EnumAlias("warning", "warn")),
+// which must be one of the values provided to NewEnum. Aliases are matched in the order registered.
+func EnumAlias(alias, value string) EnumOption {
+	return func(this *Enum) { this.aliases = append(this.aliases, enumAlias{alias: alias, value: value}) }
+}
+
+// Values returns the allowed values, in the order provided to NewEnum.
+func (this *Enum) Values() []string {
+	return this.values
+}
+
+// Parse returns the allowed value matching the raw value (or the value of the matching alias),
+// or a *ParseError (see ErrMalformedValue) which lists the allowed values.
+func (this *Enum) Parse(raw string) (string, error) {
+	if value, found := this.value(raw); found {
+		return value, nil
+	}
+	for _, alias := range this.aliases {
+		if this.matches(raw, alias.alias) {
+			return alias.value, nil
+		}
+	}
+
+	return "", &ParseError{Value: raw, Err: fmt.Errorf("expected one of: %s", strings.Join(this.values, ", "))}
+}
+func (this *Enum) value(raw string) (string, bool) {
+	for _, value := range this.values {
+		if this.matches(raw, value) {
+			return value, true
+		}
+	}
+	return "", false
+}
+func (this *Enum) matches(raw, value string) bool {
+	if this.insensitive {
+		return strings.EqualFold(raw, value)
+	}
+	return raw == value
+}
+
+// RegisterEnum registers a parser (see RegisterParser) which constrains values of type T to those
+// allowed by the provided Enum, so that they can be read with Get (and its variants).
+func RegisterEnum[T ~string](enum *Enum) {
+	RegisterParser(func(raw string) (T, error) {
+		value, err := enum.Parse(raw)
+		return T(value), err
+	})
+}
+
+/* ////////////////////////////////////////////////////////////////////////////////////////////////////////////////// */
+
+// Enums returns all values associated with the given key, as allowed by the provided Enum, or nil
+// if the key does not exist or any value is not allowed.
+func (this *Reader) Enums(key string, enum *Enum) []string {
+	value, _ := this.EnumsError(key, enum)
+	return value
+}
+
+// EnumsError returns all values associated with the given key, as allowed by the provided Enum, with an error
+// if the key does not exist or any value is not allowed (a *ParseError, see Enum.Parse).
+func (this *Reader) EnumsError(key string, enum *Enum) ([]string, error) {
+	return parseAll(this, key, enum.Parse)
+}
+
+// EnumsPanic returns all values associated with the given key, as allowed by the provided Enum, or panics
+// if the key does not exist or any value is not allowed.
+func (this *Reader) EnumsPanic(key string, enum *Enum) []string {
+	if value, err := this.EnumsError(key, enum); err != nil {
+		panic(err)
+	} else {
+		return value
+	}
+}
+
+// EnumsFatal returns all values associated with the given key, as allowed by the provided Enum, or calls log.Fatal()
+// if the key does not exist or any value is not allowed.
+func (this *Reader) EnumsFatal(key string, enum *Enum) []string {
+	if value, err := this.EnumsError(key, enum); err != nil {
+		this.fatal(key, err)
+		return nil
+	} else {
+		return value
+	}
+}
+
+// EnumsDefault returns all values associated with the given key, as allowed by the provided Enum, or the provided defaults
+// if the key does not exist or any value is not allowed.
+func (this *Reader) EnumsDefault(key string, enum *Enum, Default []string) []string {
+	if value, err := this.EnumsError(key, enum); err != nil {
+		return Default
+	} else {
+		return value
+	}
+}
+
+/* ////////////////////////////////////////////////////////////////////////////////////////////////////////////////// */
+
+// Enum returns the first value associated with the given key, as allowed by the provided Enum, or an empty string
+// if the key does not exist or the value is not allowed.
+func (this *Reader) Enum(key string, enum *Enum) string {
+	value, _ := this.EnumError(key, enum)
+	return value
+}
+
+// EnumError returns the first value associated with the given key, as allowed by the provided Enum, with an error
+// if the key does not exist or the value is not allowed (a *ParseError, see Enum.Parse).
+func (this *Reader) EnumError(key string, enum *Enum) (string, error) {
+	return parseFirst(this, key, enum.Parse)
+}
+
+// EnumPanic returns the first value associated with the given key, as allowed by the provided Enum, or panics
+// if the key does not exist or the value is not allowed.
+func (this *Reader) EnumPanic(key string, enum *Enum) string {
+	if value, err := this.EnumError(key, enum); err != nil {
+		panic(err)
+	} else {
+		return value
+	}
+}
+
+// EnumFatal returns the first value associated with the given key, as allowed by the provided Enum, or calls log.Fatal()
+// if the key does not exist or the value is not allowed.
+func (this *Reader) EnumFatal(key string, enum *Enum) string {
+	if value, err := this.EnumError(key, enum); err != nil {
+		this.fatal(key, err)
+		return ""
+	} else {
+		return value
+	}
+}
+
+// EnumDefault returns the first value associated with the given key, as allowed by the provided Enum, or the provided default
+// if the key does not exist or the value is not allowed.
+func (this *Reader) EnumDefault(key string, enum *Enum, Default string) string {
+	if value, err := this.EnumError(key, enum); err != nil {
+		return Default
+	} else {
+		return value
+	}
+}
@@ -0,0 +1,105 @@
+package configo
+
+import (
+	"testing"
+
+	"github.com/smartystreets/assertions/should"
+	"github.com/smartystreets/gunit"
+)
+
+func TestReaderEnumFixture(t *testing.T) {
+	gunit.Run(new(ReaderEnumFixture), t)
+}
+
+type ReaderEnumFixture struct {
+	*gunit.Fixture
+
+	reader *Reader
+	levels *Enum
+}
+
+func (this *ReaderEnumFixture) Setup() {
+	this.reader = NewReader(NewDefaultSource(
+		Default("log-level", "INFO"),
+		Default("log-levels", "debug", "warning", "Error"),
+		Default("log-level-typo", "inf"),
+		Default("mode", "fast"),
+	))
+	this.levels = NewEnum([]string{"debug", "info", "warn", "error"},
+		EnumCaseInsensitive(),
+		EnumAlias("warning", "warn"),
+	)
+}
+
+func (this *ReaderEnumFixture) TestAllowedValues() {
+	this.So(this.reader.Enum("log-level", this.levels), should.Equal, "info")
+	this.So(this.reader.Enums("log-levels", this.levels), should.Resemble, []string{"debug", "warn", "error"})
+	this.So(this.levels.Values(), should.Resemble, []string{"debug", "info", "warn", "error"})
+}
+
+func (this *ReaderEnumFixture) TestCaseSensitiveByDefault() {
+	modes := NewEnum([]string{"fast", "safe"}, EnumAlias("quick", "fast"))
+
+	value, err := modes.Parse("FAST")
+	this.So(value, should.BeEmpty)
+	this.So(err, should.Wrap, ErrMalformedValue)
+	this.So(this.reader.Enum("mode", modes), should.Equal, "fast")
+	this.So(this.must(modes.Parse("quick")), should.Equal, "fast")
+}
+
+func (this *ReaderEnumFixture) TestAliasesMatchInRegisteredOrder() {
+	for i := 0; i < 10; i++ {
+		modes := NewEnum([]string{"fast", "safe"}, EnumCaseInsensitive(), EnumAlias("q", "fast"), EnumAlias("Q", "safe"))
+		this.So(this.must(modes.Parse("Q")), should.Equal, "fast")
+	}
+}
+
+func (this *ReaderEnumFixture) TestAliasMustReferToAllowedValue() {
+	this.So(func() { NewEnum([]string{"fast", "safe"}, EnumAlias("quick", "speedy")) }, should.Panic)
+	this.So(func() { NewEnum([]string{"fast", "safe"}, EnumAlias("quick", "FAST")) }, should.Panic)
+
+	modes := NewEnum([]string{"fast", "safe"}, EnumCaseInsensitive(), EnumAlias("quick", "FAST"))
+	this.So(this.must(modes.Parse("quick")), should.Equal, "fast")
+}
+
+func (this *ReaderEnumFixture) TestDisallowedValue() {
+	value, err := this.reader.EnumError("log-level-typo", this.levels)
+	this.So(value, should.BeEmpty)
+	this.So(err, should.Wrap, ErrMalformedValue)
+	this.So(err.Error(), should.EndWith, `has value "inf": expected one of: debug, info, warn, error`)
+
+	_, err = this.reader.EnumsError("log-level-typo", this.levels)
+	this.So(err, should.Wrap, ErrMalformedValue)
+}
+
+func (this *ReaderEnumFixture) TestVariants() {
+	_, err := this.reader.EnumError("missing", this.levels)
+	this.So(err, should.Wrap, ErrKeyNotFound)
+	this.So(this.reader.EnumDefault("log-level-typo", this.levels, "info"), should.Equal, "info")
+	this.So(this.reader.EnumsDefault("missing", this.levels, []string{"warn"}), should.Resemble, []string{"warn"})
+	this.So(func() { this.reader.EnumPanic("log-level-typo", this.levels) }, should.Panic)
+
+	var key string
+	this.reader.fatal = func(k string, e error) { err = e; key = k }
+	this.reader.EnumsFatal("log-level-typo", this.levels)
+	this.So(key, should.Equal, "log-level-typo")
+	this.So(err, should.Wrap, ErrMalformedValue)
+}
+
+func (this *ReaderEnumFixture) TestRegisteredEnum() {
+	RegisterEnum[enumLevel](this.levels)
+
+	this.So(Get[enumLevel](this.reader, "log-level"), should.Equal, enumLevel("info"))
+	this.So(GetAll[enumLevel](this.reader, "log-levels"), should.Resemble, []enumLevel{"debug", "warn", "error"})
+
+	_, err := GetError[enumLevel](this.reader, "log-level-typo")
+	this.So(err, should.Wrap, ErrMalformedValue)
+	this.So(err.Error(), should.ContainSubstring, "debug, info, warn, error")
+}
+
+func (this *ReaderEnumFixture) must(value string, err error) string {
+	this.So(err, should.BeNil)
+	return value
+}
+
+type enumLevel string
@@ -0,0 +1,180 @@
+package configo
+
+import (
+	"net/url"
+	"reflect"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// RegisterParser registers the function used by Get (and its variants) to parse values of type T.
+// Parsers are built in for string, bool, all sized integer and float types, time.Duration, time.Time
+// (in the location registered with Reader.RegisterTimeLocation, see AutoTimeFormat), url.URL,
+// *url.URL, net.IP, *net.IPNet, netip.Prefix, netip.AddrPort and *regexp.Regexp.
+// Registering a parser for a type which already has one (including the built-in types) replaces it.
+// Any error returned by the parser is reported as a *ParseError (see ErrMalformedValue) which wraps it.
+func RegisterParser[T any](parser func(string) (T, error)) {
+	parsers.Lock()
+	defer parsers.Unlock()
+	parsers.registry[typeOf[T]()] = parser
+}
+
+// Get returns the first value associated with the given key, parsed as T, or the zero value
+// if the key does not exist or the value could not be parsed.
+func Get[T any](reader *Reader, key string) T {
+	value, _ := GetError[T](reader, key)
+	return value
+}
+
+// GetError returns the first value associated with the given key, parsed as T, with an error
+// if the key does not exist (*KeyError), the value could not be parsed (*ParseError),
+// or no parser has been registered for T (ErrUnregisteredType).
+func GetError[T any](reader *Reader, key string) (T, error) {
+	parser, err := parserOf[T](reader)
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+
+	return parseFirst(reader, key, parser)
+}
+
+// GetPanic returns the first value associated with the given key, parsed as T, or panics
+// if the key does not exist or the value could not be parsed.
+func GetPanic[T any](reader *Reader, key string) T {
+	if value, err := GetError[T](reader, key); err != nil {
+		panic(err)
+	} else {
+		return value
+	}
+}
+
+// GetFatal returns the first value associated with the given key, parsed as T, or calls log.Fatal()
+// if the key does not exist or the value could not be parsed.
+func GetFatal[T any](reader *Reader, key string) T {
+	if value, err := GetError[T](reader, key); err != nil {
+		reader.fatal(key, err)
+		var zero T
+		return zero
+	} else {
+		return value
+	}
+}
+
+// GetDefault returns the first value associated with the given key, parsed as T, or the provided default
+// if the key does not exist or the value could not be parsed.
+func GetDefault[T any](reader *Reader, key string, Default T) T {
+	if value, err := GetError[T](reader, key); err != nil {
+		return Default
+	} else {
+		return value
+	}
+}
+
+/* ////////////////////////////////////////////////////////////////////////////////////////////////////////////////// */
+
+// GetAll returns all values associated with the given key, parsed as T, or nil
+// if the key does not exist or the values could not be parsed.
+func GetAll[T any](reader *Reader, key string) []T {
+	values, _ := GetAllError[T](reader, key)
+	return values
+}
+
+// GetAllError returns all values associated with the given key, parsed as T, with an error
+// if the key does not exist (*KeyError), the values could not be parsed (*ParseError),
+// or no parser has been registered for T (ErrUnregisteredType).
+func GetAllError[T any](reader *Reader, key string) ([]T, error) {
+	parser, err := parserOf[T](reader)
+	if err != nil {
+		return nil, err
+	}
+
+	return parseAll(reader, key, parser)
+}
+
+// GetAllPanic returns all values associated with the given key, parsed as T, or panics
+// if the key does not exist or the values could not be parsed.
+func GetAllPanic[T any](reader *Reader, key string) []T {
+	if values, err := GetAllError[T](reader, key); err != nil {
+		panic(err)
+	} else {
+		return values
+	}
+}
+
+// GetAllFatal returns all values associated with the given key, parsed as T, or calls log.Fatal()
+// if the key does not exist or the values could not be parsed.
+func GetAllFatal[T any](reader *Reader, key string) []T {
+	if values, err := GetAllError[T](reader, key); err != nil {
+		reader.fatal(key, err)
+		return nil
+	} else {
+		return values
+	}
+}
+
+// GetAllDefault returns all values associated with the given key, parsed as T, or the provided defaults
+// if the key does not exist or the values could not be parsed.
+func GetAllDefault[T any](reader *Reader, key string, Default []T) []T {
+	if values, err := GetAllError[T](reader, key); err != nil {
+		return Default
+	} else {
+		return values
+	}
+}
+
+/* ////////////////////////////////////////////////////////////////////////////////////////////////////////////////// */
+
+var parsers = struct {
+	sync.RWMutex
+	registry map[reflect.Type]interface{}
+}{registry: make(map[reflect.Type]interface{})}
+
+func typeOf[T any]() reflect.Type {
+	return reflect.TypeOf((*T)(nil)).Elem()
+}
+
+// parserOf returns the parser registered for T. Built-in parsers which depend on the configuration
+// of the reader (such as the location of times) are registered as a func(*Reader, string) (T, error).
+func parserOf[T any](reader *Reader) (func(string) (T, error), error) {
+	parsers.RLock()
+	defer parsers.RUnlock()
+
+	switch parser := parsers.registry[typeOf[T]()].(type) {
+	case func(string) (T, error):
+		return parser, nil
+	case func(*Reader, string) (T, error):
+		return func(raw string) (T, error) { return parser(reader, raw) }, nil
+	default:
+		return nil, ErrUnregisteredType
+	}
+}
+
+func init() {
+	RegisterParser(func(raw string) (string, error) { return raw, nil })
+	RegisterParser(strconv.Atoi)
+	RegisterParser(strconv.ParseBool)
+	RegisterParser(parseInt64)
+	RegisterParser(parseInt32)
+	RegisterParser(parseInt16)
+	RegisterParser(parseInt8)
+	RegisterParser(parseUint)
+	RegisterParser(parseUint64)
+	RegisterParser(parseUint32)
+	RegisterParser(parseUint16)
+	RegisterParser(parseUint8)
+	RegisterParser(parseFloat64)
+	RegisterParser(parseFloat32)
+	RegisterParser(time.ParseDuration)
+	parsers.registry[typeOf[time.Time]()] = func(reader *Reader, raw string) (time.Time, error) {
+		return parseTime(raw, AutoTimeFormat, reader.location)
+	}
+	RegisterParser(parseURL)
+	RegisterParser(url.Parse)
+	RegisterParser(parseIP)
+	RegisterParser(parseIPNet)
+	RegisterParser(parsePrefix)
+	RegisterParser(parseAddrPort)
+	RegisterParser(parseRegexp)
+}
@@ -0,0 +1,108 @@
+package configo
+
+import (
+	"errors"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions/should"
+	"github.com/smartystreets/gunit"
+)
+
+func TestReaderGenericFixture(t *testing.T) {
+	gunit.Run(new(ReaderGenericFixture), t)
+}
+
+type ReaderGenericFixture struct {
+	*gunit.Fixture
+
+	reader *Reader
+}
+
+func (this *ReaderGenericFixture) Setup() {
+	this.reader = NewReader(NewDefaultSource(
+		Default("ports", "8080", "8443"),
+		Default("timeout", "5s"),
+		Default("enabled", "true"),
+		Default("address", "10.0.0.1"),
+		Default("bad", "not-a-number"),
+		Default("colors", "red", "GREEN"),
+	))
+}
+
+func (this *ReaderGenericFixture) TestBuiltInTypes() {
+	this.So(Get[int](this.reader, "ports"), should.Equal, 8080)
+	this.So(Get[uint16](this.reader, "ports"), should.Equal, uint16(8080))
+	this.So(Get[string](this.reader, "ports"), should.Equal, "8080")
+	this.So(Get[time.Duration](this.reader, "timeout"), should.Equal, 5*time.Second)
+	this.So(Get[bool](this.reader, "enabled"), should.BeTrue)
+	this.So(Get[net.IP](this.reader, "address").String(), should.Equal, "10.0.0.1")
+	this.So(GetAll[int](this.reader, "ports"), should.Resemble, []int{8080, 8443})
+}
+
+func (this *ReaderGenericFixture) TestTimeInRegisteredLocation() {
+	reader := NewReader(NewDefaultSource(Default("start", "2024-01-02 03:04:05")))
+	this.So(Get[time.Time](reader, "start").Location(), should.Equal, time.UTC)
+
+	this.So(reader.RegisterTimeLocation("America/Denver"), should.BeNil)
+
+	start := Get[time.Time](reader, "start")
+	this.So(start.Location().String(), should.Equal, "America/Denver")
+	this.So(start.Equal(reader.Time("start", AutoTimeFormat)), should.BeTrue)
+	this.So(GetAll[time.Time](reader, "start")[0].Location().String(), should.Equal, "America/Denver")
+}
+
+func (this *ReaderGenericFixture) TestMissingKey() {
+	value, err := GetError[int](this.reader, "missing")
+	this.So(value, should.Equal, 0)
+	this.So(err, should.Wrap, ErrKeyNotFound)
+	this.So(GetDefault(this.reader, "missing", 42), should.Equal, 42)
+	this.So(GetAllDefault(this.reader, "missing", []int{1}), should.Resemble, []int{1})
+	this.So(func() { GetPanic[int](this.reader, "missing") }, should.Panic)
+}
+
+func (this *ReaderGenericFixture) TestMalformedValue() {
+	value, err := GetError[int](this.reader, "bad")
+	this.So(value, should.Equal, 0)
+	this.So(err, should.Wrap, ErrMalformedValue)
+
+	values, err := GetAllError[int](this.reader, "bad")
+	this.So(values, should.BeNil)
+	this.So(err, should.Wrap, ErrMalformedValue)
+
+	var key string
+	this.reader.fatal = func(k string, e error) { err = e; key = k }
+	GetAllFatal[time.Duration](this.reader, "bad")
+	this.So(key, should.Equal, "bad")
+	this.So(err, should.Wrap, ErrMalformedValue)
+}
+
+func (this *ReaderGenericFixture) TestUnregisteredType() {
+	_, err := GetError[genericColor](this.reader, "colors")
+	this.So(err, should.Equal, ErrUnregisteredType)
+	this.So(func() { GetPanic[struct{}](this.reader, "colors") }, should.Panic)
+}
+
+func (this *ReaderGenericFixture) TestRegisteredParser() {
+	RegisterParser(parseGenericColor)
+
+	this.So(Get[genericColor](this.reader, "colors"), should.Equal, genericColor("red"))
+	this.So(GetAll[genericColor](this.reader, "colors"), should.Resemble, []genericColor{"red", "green"})
+	this.So(GetDefault(this.reader, "ports", genericColor("blue")), should.Equal, genericColor("blue"))
+
+	_, err := GetError[genericColor](this.reader, "ports")
+	this.So(err, should.Wrap, ErrMalformedValue)
+}
+
+type genericColor string
+
+func parseGenericColor(raw string) (genericColor, error) {
+	switch raw = strings.ToLower(raw); raw {
+	case "red", "green", "blue":
+		return genericColor(raw), nil
+	default:
+		return "", errors.New("unknown color")
+	}
+}
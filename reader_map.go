@@ -0,0 +1,110 @@
+package configo
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// StringMap returns the key/value pairs associated with the given key or nil
+// if the key does not exist or the values are malformed.
+func (this *Reader) StringMap(key string) map[string]string {
+	value, _ := this.StringMapError(key)
+	return value
+}
+
+// StringMapError returns the key/value pairs associated with the given key with an error
+// if the key does not exist or the values are malformed. Sources which implement MapSource
+// (ex: a nested JSON object) provide the pairs directly, otherwise each value is parsed as a
+// comma-separated list of key=value entries (ex: "region=us-east,tier=gold"). Duplicate keys
+// and entries without an equal sign (or with an empty key) result in a *ParseError.
+func (this *Reader) StringMapError(key string) (map[string]string, error) {
+	for _, alias := range this.resolvePossibleKeys(key) {
+		if value, source, raw, err := this.stringMapError(alias); !errors.Is(err, ErrKeyNotFound) {
+			if err != nil {
+				return nil, this.parseError(key, source, raw, err)
+			}
+			return value, nil
+		}
+	}
+
+	return nil, &KeyError{Key: key, Aliases: this.aliasesOf(key)}
+}
+func (this *Reader) stringMapError(key string) (map[string]string, Source, string, error) {
+	for _, source := range this.sources {
+		if hierarchical, ok := source.(MapSource); ok {
+			if value, err := hierarchical.StringMap(key); !errors.Is(err, ErrKeyNotFound) {
+				return value, source, "", err
+			}
+		}
+
+		if value, err := source.Strings(key); err == nil {
+			if len(value) > 0 && strings.HasPrefix(value[0], "env:") {
+				key = value[0] // see stringsError
+				continue
+			}
+			parsed, err := parseStringMap(value)
+			return parsed, source, strings.Join(value, ","), err
+		} else if errors.Is(err, ErrMalformedValue) {
+			return nil, source, "", err
+		}
+	}
+
+	return nil, nil, "", ErrKeyNotFound
+}
+
+// StringMapPanic returns the key/value pairs associated with the given key or panics
+// if the key does not exist or the values are malformed.
+func (this *Reader) StringMapPanic(key string) map[string]string {
+	if value, err := this.StringMapError(key); err != nil {
+		panic(err)
+	} else {
+		return value
+	}
+}
+
+// StringMapFatal returns the key/value pairs associated with the given key or calls log.Fatal()
+// if the key does not exist or the values are malformed.
+func (this *Reader) StringMapFatal(key string) map[string]string {
+	if value, err := this.StringMapError(key); err != nil {
+		this.fatal(key, err)
+		return nil
+	} else {
+		return value
+	}
+}
+
+// StringMapDefault returns the key/value pairs associated with the given key or the provided default
+// if the key does not exist or the values are malformed.
+func (this *Reader) StringMapDefault(key string, Default map[string]string) map[string]string {
+	if value, err := this.StringMapError(key); err != nil {
+		return Default
+	} else {
+		return value
+	}
+}
+
+func parseStringMap(values []string) (map[string]string, error) {
+	parsed := make(map[string]string)
+	for _, value := range values {
+		for _, entry := range strings.Split(value, ",") {
+			if entry = strings.TrimSpace(entry); len(entry) == 0 {
+				continue
+			}
+
+			equals := strings.Index(entry, "=")
+			if equals < 0 {
+				return nil, fmt.Errorf("missing '=' in entry %q", entry)
+			}
+
+			name := strings.TrimSpace(entry[:equals])
+			if len(name) == 0 {
+				return nil, fmt.Errorf("missing key in entry %q", entry)
+			} else if _, duplicate := parsed[name]; duplicate {
+				return nil, fmt.Errorf("duplicate key %q", name)
+			}
+			parsed[name] = strings.TrimSpace(entry[equals+1:])
+		}
+	}
+	return parsed, nil
+}
@@ -0,0 +1,83 @@
+package configo
+
+import (
+	"testing"
+
+	"github.com/smartystreets/assertions/should"
+	"github.com/smartystreets/gunit"
+)
+
+func TestReaderMapFixture(t *testing.T) {
+	gunit.Run(new(ReaderMapFixture), t)
+}
+
+type ReaderMapFixture struct {
+	*gunit.Fixture
+
+	reader *Reader
+}
+
+func (this *ReaderMapFixture) Setup() {
+	this.reader = NewReader(
+		FromJSONContent([]byte(`{
+			"labels": {"region": "us-west", "replicas": 3, "debug": true, "tags": ["a", "b"], "empty": null},
+			"duplicates": {"region": "us-west", "region": "us-east"},
+			"listed": ["zone=a", "rack=12"],
+			"scalar": "not-an-object"
+		}`)),
+		NewDefaultSource(
+			Default("labels", "region=us-east"),
+			Default("entries", "region=us-east, tier=gold", "url=http://host/?a=b"),
+			Default("duplicate-entries", "a=1,a=2"),
+			Default("missing-equals", "a=1,b"),
+			Default("empty-key", "=1"),
+			Default("empty", ""),
+		),
+	)
+}
+
+func (this *ReaderMapFixture) TestKeyValueEntries() {
+	this.So(this.reader.StringMap("entries"), should.Resemble, map[string]string{
+		"region": "us-east",
+		"tier":   "gold",
+		"url":    "http://host/?a=b",
+	})
+	this.So(this.reader.StringMap("listed"), should.Resemble, map[string]string{"zone": "a", "rack": "12"})
+	this.So(this.reader.StringMap("empty"), should.Resemble, map[string]string{})
+}
+
+func (this *ReaderMapFixture) TestNestedObject() {
+	this.So(this.reader.StringMap("labels"), should.Resemble, map[string]string{
+		"region":   "us-west",
+		"replicas": "3",
+		"debug":    "true",
+		"tags":     `["a","b"]`,
+		"empty":    "",
+	})
+}
+
+func (this *ReaderMapFixture) TestMalformedEntries() {
+	for _, key := range []string{"duplicates", "duplicate-entries", "missing-equals", "empty-key", "scalar"} {
+		value, err := this.reader.StringMapError(key)
+		this.So(value, should.BeNil)
+		this.So(err, should.Wrap, ErrMalformedValue)
+	}
+}
+
+func (this *ReaderMapFixture) TestVariants() {
+	_, err := this.reader.StringMapError("missing")
+	this.So(err, should.Wrap, ErrKeyNotFound)
+	this.So(this.reader.StringMapDefault("missing", map[string]string{"a": "b"}), should.Resemble, map[string]string{"a": "b"})
+	this.So(func() { this.reader.StringMapPanic("duplicates") }, should.Panic)
+
+	var key string
+	this.reader.fatal = func(k string, e error) { err = e; key = k }
+	this.reader.StringMapFatal("missing-equals")
+	this.So(key, should.Equal, "missing-equals")
+	this.So(err, should.Wrap, ErrMalformedValue)
+}
+
+func (this *ReaderMapFixture) TestAlias() {
+	this.reader.RegisterAlias("entries", "pairs")
+	this.So(this.reader.StringMap("pairs")["tier"], should.Equal, "gold")
+}
@@ -0,0 +1,552 @@
+package configo
+
+import (
+	"fmt"
+	"net"
+	"net/netip"
+	"strconv"
+	"strings"
+)
+
+/* ////////////////////////////////////////////////////////////////////////////////////////////////////////////////// */
+
+// IPs returns all IP address values associated with the given key or returns nil
+// if the key does not exist or the values could not be parsed as IP addresses.
+// For examples of IP addresses see http://golang.org/pkg/net/#ParseIP
+func (this *Reader) IPs(key string) []net.IP {
+	value, _ := this.IPsError(key)
+	return value
+}
+
+// IPsError returns all IP address values associated with the given key with an error
+// if the key does not exist or the values could not be parsed as IP addresses.
+func (this *Reader) IPsError(key string) ([]net.IP, error) {
+	return parseAll(this, key, parseIP)
+}
+
+// IPsPanic returns all IP address values associated with the given key or panics
+// if the key does not exist or the values could not be parsed as IP addresses.
+func (this *Reader) IPsPanic(key string) []net.IP {
+	if value, err := this.IPsError(key); err != nil {
+		panic(err)
+	} else {
+		return value
+	}
+}
+
+// IPsFatal returns all IP address values associated with the given key or calls log.Fatal()
+// if the key does not exist or the values could not be parsed as IP addresses.
+func (this *Reader) IPsFatal(key string) []net.IP {
+	if value, err := this.IPsError(key); err != nil {
+		this.fatal(key, err)
+		return nil
+	} else {
+		return value
+	}
+}
+
+// IPsDefault returns all IP address values associated with the given key or returns provided defaults
+// if the key does not exist or the values could not be parsed as IP addresses.
+func (this *Reader) IPsDefault(key string, Default []net.IP) []net.IP {
+	if value, err := this.IPsError(key); err != nil {
+		return Default
+	} else {
+		return value
+	}
+}
+
+/* ////////////////////////////////////////////////////////////////////////////////////////////////////////////////// */
+
+// IP returns the first IP address value associated with the given key or returns the zero value
+// if the key does not exist or the value could not be parsed as an IP address.
+// For examples of IP addresses see http://golang.org/pkg/net/#ParseIP
+func (this *Reader) IP(key string) net.IP {
+	value, _ := this.IPError(key)
+	return value
+}
+
+// IPError returns the first IP address value associated with the given key with an error
+// if the key does not exist or the value could not be parsed as an IP address.
+func (this *Reader) IPError(key string) (net.IP, error) {
+	return parseFirst(this, key, parseIP)
+}
+
+// IPPanic returns the first IP address value associated with the given key or panics
+// if the key does not exist or the value could not be parsed as an IP address.
+func (this *Reader) IPPanic(key string) net.IP {
+	if value, err := this.IPError(key); err != nil {
+		panic(err)
+	} else {
+		return value
+	}
+}
+
+// IPFatal returns the first IP address value associated with the given key or calls log.Fatal()
+// if the key does not exist or the value could not be parsed as an IP address.
+func (this *Reader) IPFatal(key string) net.IP {
+	if value, err := this.IPError(key); err != nil {
+		this.fatal(key, err)
+		return nil
+	} else {
+		return value
+	}
+}
+
+// IPDefault returns the first IP address value associated with the given key or returns the provided default
+// if the key does not exist or the value could not be parsed as an IP address.
+func (this *Reader) IPDefault(key string, Default net.IP) net.IP {
+	if value, err := this.IPError(key); err != nil {
+		return Default
+	} else {
+		return value
+	}
+}
+
+/* ////////////////////////////////////////////////////////////////////////////////////////////////////////////////// */
+
+// IPNets returns all CIDR values associated with the given key or returns nil
+// if the key does not exist or the values could not be parsed as CIDRs.
+// For examples of CIDR notation see http://golang.org/pkg/net/#ParseCIDR
+func (this *Reader) IPNets(key string) []*net.IPNet {
+	value, _ := this.IPNetsError(key)
+	return value
+}
+
+// IPNetsError returns all CIDR values associated with the given key with an error
+// if the key does not exist or the values could not be parsed as CIDRs.
+func (this *Reader) IPNetsError(key string) ([]*net.IPNet, error) {
+	return parseAll(this, key, parseIPNet)
+}
+
+// IPNetsPanic returns all CIDR values associated with the given key or panics
+// if the key does not exist or the values could not be parsed as CIDRs.
+func (this *Reader) IPNetsPanic(key string) []*net.IPNet {
+	if value, err := this.IPNetsError(key); err != nil {
+		panic(err)
+	} else {
+		return value
+	}
+}
+
+// IPNetsFatal returns all CIDR values associated with the given key or calls log.Fatal()
+// if the key does not exist or the values could not be parsed as CIDRs.
+func (this *Reader) IPNetsFatal(key string) []*net.IPNet {
+	if value, err := this.IPNetsError(key); err != nil {
+		this.fatal(key, err)
+		return nil
+	} else {
+		return value
+	}
+}
+
+// IPNetsDefault returns all CIDR values associated with the given key or returns provided defaults
+// if the key does not exist or the values could not be parsed as CIDRs.
+func (this *Reader) IPNetsDefault(key string, Default []*net.IPNet) []*net.IPNet {
+	if value, err := this.IPNetsError(key); err != nil {
+		return Default
+	} else {
+		return value
+	}
+}
+
+/* ////////////////////////////////////////////////////////////////////////////////////////////////////////////////// */
+
+// IPNet returns the first CIDR value associated with the given key or returns the zero value
+// if the key does not exist or the value could not be parsed as a CIDR.
+// For examples of CIDR notation see http://golang.org/pkg/net/#ParseCIDR
+func (this *Reader) IPNet(key string) *net.IPNet {
+	value, _ := this.IPNetError(key)
+	return value
+}
+
+// IPNetError returns the first CIDR value associated with the given key with an error
+// if the key does not exist or the value could not be parsed as a CIDR.
+func (this *Reader) IPNetError(key string) (*net.IPNet, error) {
+	return parseFirst(this, key, parseIPNet)
+}
+
+// IPNetPanic returns the first CIDR value associated with the given key or panics
+// if the key does not exist or the value could not be parsed as a CIDR.
+func (this *Reader) IPNetPanic(key string) *net.IPNet {
+	if value, err := this.IPNetError(key); err != nil {
+		panic(err)
+	} else {
+		return value
+	}
+}
+
+// IPNetFatal returns the first CIDR value associated with the given key or calls log.Fatal()
+// if the key does not exist or the value could not be parsed as a CIDR.
+func (this *Reader) IPNetFatal(key string) *net.IPNet {
+	if value, err := this.IPNetError(key); err != nil {
+		this.fatal(key, err)
+		return nil
+	} else {
+		return value
+	}
+}
+
+// IPNetDefault returns the first CIDR value associated with the given key or returns the provided default
+// if the key does not exist or the value could not be parsed as a CIDR.
+func (this *Reader) IPNetDefault(key string, Default *net.IPNet) *net.IPNet {
+	if value, err := this.IPNetError(key); err != nil {
+		return Default
+	} else {
+		return value
+	}
+}
+
+/* ////////////////////////////////////////////////////////////////////////////////////////////////////////////////// */
+
+// Prefixes returns all netip.Prefix values associated with the given key or returns nil
+// if the key does not exist or the values could not be parsed as netip.Prefixes.
+// For examples of prefixes see http://golang.org/pkg/net/netip/#ParsePrefix
+func (this *Reader) Prefixes(key string) []netip.Prefix {
+	value, _ := this.PrefixesError(key)
+	return value
+}
+
+// PrefixesError returns all netip.Prefix values associated with the given key with an error
+// if the key does not exist or the values could not be parsed as netip.Prefixes.
+func (this *Reader) PrefixesError(key string) ([]netip.Prefix, error) {
+	return parseAll(this, key, parsePrefix)
+}
+
+// PrefixesPanic returns all netip.Prefix values associated with the given key or panics
+// if the key does not exist or the values could not be parsed as netip.Prefixes.
+func (this *Reader) PrefixesPanic(key string) []netip.Prefix {
+	if value, err := this.PrefixesError(key); err != nil {
+		panic(err)
+	} else {
+		return value
+	}
+}
+
+// PrefixesFatal returns all netip.Prefix values associated with the given key or calls log.Fatal()
+// if the key does not exist or the values could not be parsed as netip.Prefixes.
+func (this *Reader) PrefixesFatal(key string) []netip.Prefix {
+	if value, err := this.PrefixesError(key); err != nil {
+		this.fatal(key, err)
+		return nil
+	} else {
+		return value
+	}
+}
+
+// PrefixesDefault returns all netip.Prefix values associated with the given key or returns provided defaults
+// if the key does not exist or the values could not be parsed as netip.Prefixes.
+func (this *Reader) PrefixesDefault(key string, Default []netip.Prefix) []netip.Prefix {
+	if value, err := this.PrefixesError(key); err != nil {
+		return Default
+	} else {
+		return value
+	}
+}
+
+/* ////////////////////////////////////////////////////////////////////////////////////////////////////////////////// */
+
+// Prefix returns the first netip.Prefix value associated with the given key or returns the zero value
+// if the key does not exist or the value could not be parsed as a netip.Prefix.
+// For examples of prefixes see http://golang.org/pkg/net/netip/#ParsePrefix
+func (this *Reader) Prefix(key string) netip.Prefix {
+	value, _ := this.PrefixError(key)
+	return value
+}
+
+// PrefixError returns the first netip.Prefix value associated with the given key with an error
+// if the key does not exist or the value could not be parsed as a netip.Prefix.
+func (this *Reader) PrefixError(key string) (netip.Prefix, error) {
+	return parseFirst(this, key, parsePrefix)
+}
+
+// PrefixPanic returns the first netip.Prefix value associated with the given key or panics
+// if the key does not exist or the value could not be parsed as a netip.Prefix.
+func (this *Reader) PrefixPanic(key string) netip.Prefix {
+	if value, err := this.PrefixError(key); err != nil {
+		panic(err)
+	} else {
+		return value
+	}
+}
+
+// PrefixFatal returns the first netip.Prefix value associated with the given key or calls log.Fatal()
+// if the key does not exist or the value could not be parsed as a netip.Prefix.
+func (this *Reader) PrefixFatal(key string) netip.Prefix {
+	if value, err := this.PrefixError(key); err != nil {
+		this.fatal(key, err)
+		return netip.Prefix{}
+	} else {
+		return value
+	}
+}
+
+// PrefixDefault returns the first netip.Prefix value associated with the given key or returns the provided default
+// if the key does not exist or the value could not be parsed as a netip.Prefix.
+func (this *Reader) PrefixDefault(key string, Default netip.Prefix) netip.Prefix {
+	if value, err := this.PrefixError(key); err != nil {
+		return Default
+	} else {
+		return value
+	}
+}
+
+/* ////////////////////////////////////////////////////////////////////////////////////////////////////////////////// */
+
+// AddrPorts returns all netip.AddrPort values associated with the given key or returns nil
+// if the key does not exist or the values could not be parsed as netip.AddrPorts.
+// For examples of address/port pairs see http://golang.org/pkg/net/netip/#ParseAddrPort
+func (this *Reader) AddrPorts(key string) []netip.AddrPort {
+	value, _ := this.AddrPortsError(key)
+	return value
+}
+
+// AddrPortsError returns all netip.AddrPort values associated with the given key with an error
+// if the key does not exist or the values could not be parsed as netip.AddrPorts.
+func (this *Reader) AddrPortsError(key string) ([]netip.AddrPort, error) {
+	return parseAll(this, key, parseAddrPort)
+}
+
+// AddrPortsPanic returns all netip.AddrPort values associated with the given key or panics
+// if the key does not exist or the values could not be parsed as netip.AddrPorts.
+func (this *Reader) AddrPortsPanic(key string) []netip.AddrPort {
+	if value, err := this.AddrPortsError(key); err != nil {
+		panic(err)
+	} else {
+		return value
+	}
+}
+
+// AddrPortsFatal returns all netip.AddrPort values associated with the given key or calls log.Fatal()
+// if the key does not exist or the values could not be parsed as netip.AddrPorts.
+func (this *Reader) AddrPortsFatal(key string) []netip.AddrPort {
+	if value, err := this.AddrPortsError(key); err != nil {
+		this.fatal(key, err)
+		return nil
+	} else {
+		return value
+	}
+}
+
+// AddrPortsDefault returns all netip.AddrPort values associated with the given key or returns provided defaults
+// if the key does not exist or the values could not be parsed as netip.AddrPorts.
+func (this *Reader) AddrPortsDefault(key string, Default []netip.AddrPort) []netip.AddrPort {
+	if value, err := this.AddrPortsError(key); err != nil {
+		return Default
+	} else {
+		return value
+	}
+}
+
+/* ////////////////////////////////////////////////////////////////////////////////////////////////////////////////// */
+
+// AddrPort returns the first netip.AddrPort value associated with the given key or returns the zero value
+// if the key does not exist or the value could not be parsed as a netip.AddrPort.
+// For examples of address/port pairs see http://golang.org/pkg/net/netip/#ParseAddrPort
+func (this *Reader) AddrPort(key string) netip.AddrPort {
+	value, _ := this.AddrPortError(key)
+	return value
+}
+
+// AddrPortError returns the first netip.AddrPort value associated with the given key with an error
+// if the key does not exist or the value could not be parsed as a netip.AddrPort.
+func (this *Reader) AddrPortError(key string) (netip.AddrPort, error) {
+	return parseFirst(this, key, parseAddrPort)
+}
+
+// AddrPortPanic returns the first netip.AddrPort value associated with the given key or panics
+// if the key does not exist or the value could not be parsed as a netip.AddrPort.
+func (this *Reader) AddrPortPanic(key string) netip.AddrPort {
+	if value, err := this.AddrPortError(key); err != nil {
+		panic(err)
+	} else {
+		return value
+	}
+}
+
+// AddrPortFatal returns the first netip.AddrPort value associated with the given key or calls log.Fatal()
+// if the key does not exist or the value could not be parsed as a netip.AddrPort.
+func (this *Reader) AddrPortFatal(key string) netip.AddrPort {
+	if value, err := this.AddrPortError(key); err != nil {
+		this.fatal(key, err)
+		return netip.AddrPort{}
+	} else {
+		return value
+	}
+}
+
+// AddrPortDefault returns the first netip.AddrPort value associated with the given key or returns the provided default
+// if the key does not exist or the value could not be parsed as a netip.AddrPort.
+func (this *Reader) AddrPortDefault(key string, Default netip.AddrPort) netip.AddrPort {
+	if value, err := this.AddrPortError(key); err != nil {
+		return Default
+	} else {
+		return value
+	}
+}
+
+/* ////////////////////////////////////////////////////////////////////////////////////////////////////////////////// */
+
+// HostPorts returns all host:port address values associated with the given key or returns nil
+// if the key does not exist or the values could not be parsed as host:port addresses.
+// The host may be empty (ex: ":8080"), an IP address, or a hostname (ex: "localhost") but the
+// port must be a number between 0 and 65535.
+func (this *Reader) HostPorts(key string) []string {
+	value, _ := this.HostPortsError(key)
+	return value
+}
+
+// HostPortsError returns all host:port address values associated with the given key with an error
+// if the key does not exist or the values could not be parsed as host:port addresses.
+func (this *Reader) HostPortsError(key string) ([]string, error) {
+	return parseAll(this, key, parseHostPort)
+}
+
+// HostPortsPanic returns all host:port address values associated with the given key or panics
+// if the key does not exist or the values could not be parsed as host:port addresses.
+func (this *Reader) HostPortsPanic(key string) []string {
+	if value, err := this.HostPortsError(key); err != nil {
+		panic(err)
+	} else {
+		return value
+	}
+}
+
+// HostPortsFatal returns all host:port address values associated with the given key or calls log.Fatal()
+// if the key does not exist or the values could not be parsed as host:port addresses.
+func (this *Reader) HostPortsFatal(key string) []string {
+	if value, err := this.HostPortsError(key); err != nil {
+		this.fatal(key, err)
+		return nil
+	} else {
+		return value
+	}
+}
+
+// HostPortsDefault returns all host:port address values associated with the given key or returns provided defaults
+// if the key does not exist or the values could not be parsed as host:port addresses.
+func (this *Reader) HostPortsDefault(key string, Default []string) []string {
+	if value, err := this.HostPortsError(key); err != nil {
+		return Default
+	} else {
+		return value
+	}
+}
+
+/* ////////////////////////////////////////////////////////////////////////////////////////////////////////////////// */
+
+// HostPort returns the first host:port address value associated with the given key or returns the zero value
+// if the key does not exist or the value could not be parsed as a host:port address.
+// The host may be empty (ex: ":8080") but the port must be a number between 0 and 65535.
+func (this *Reader) HostPort(key string) string {
+	value, _ := this.HostPortError(key)
+	return value
+}
+
+// HostPortError returns the first host:port address value associated with the given key with an error
+// if the key does not exist or the value could not be parsed as a host:port address.
+func (this *Reader) HostPortError(key string) (string, error) {
+	return parseFirst(this, key, parseHostPort)
+}
+
+// HostPortPanic returns the first host:port address value associated with the given key or panics
+// if the key does not exist or the value could not be parsed as a host:port address.
+func (this *Reader) HostPortPanic(key string) string {
+	if value, err := this.HostPortError(key); err != nil {
+		panic(err)
+	} else {
+		return value
+	}
+}
+
+// HostPortFatal returns the first host:port address value associated with the given key or calls log.Fatal()
+// if the key does not exist or the value could not be parsed as a host:port address.
+func (this *Reader) HostPortFatal(key string) string {
+	if value, err := this.HostPortError(key); err != nil {
+		this.fatal(key, err)
+		return ""
+	} else {
+		return value
+	}
+}
+
+// HostPortDefault returns the first host:port address value associated with the given key or returns the provided default
+// if the key does not exist or the value could not be parsed as a host:port address.
+func (this *Reader) HostPortDefault(key string, Default string) string {
+	if value, err := this.HostPortError(key); err != nil {
+		return Default
+	} else {
+		return value
+	}
+}
+
+/* ////////////////////////////////////////////////////////////////////////////////////////////////////////////////// */
+
+func parseIP(raw string) (net.IP, error) {
+	parsed := net.ParseIP(raw)
+	if parsed == nil {
+		return nil, ErrMalformedValue
+	}
+	return parsed, nil
+}
+
+func parseIPNet(raw string) (*net.IPNet, error) {
+	_, parsed, err := net.ParseCIDR(raw)
+	if err != nil {
+		return nil, err
+	}
+	return parsed, nil
+}
+
+func parsePrefix(raw string) (netip.Prefix, error) {
+	parsed, err := netip.ParsePrefix(raw)
+	if err != nil {
+		return netip.Prefix{}, err
+	}
+	return parsed, nil
+}
+
+func parseAddrPort(raw string) (netip.AddrPort, error) {
+	parsed, err := netip.ParseAddrPort(raw)
+	if err != nil {
+		return netip.AddrPort{}, err
+	}
+	return parsed, nil
+}
+
+func parseHostPort(raw string) (string, error) {
+	host, port, err := net.SplitHostPort(raw)
+	if err != nil {
+		return "", err
+	}
+	if _, err := strconv.ParseUint(port, 10, 16); err != nil {
+		return "", err
+	}
+	if _, err := netip.ParseAddr(host); err != nil && len(host) > 0 && !isHostname(host) {
+		return "", fmt.Errorf("invalid host %q", host)
+	}
+	return raw, nil
+}
+
+// isHostname reports whether the host consists of dot-separated labels of letters, digits,
+// hyphens, and underscores (up to 63 characters each) which don't begin or end with a hyphen.
+func isHostname(host string) bool {
+	if len(host) > 253 {
+		return false
+	}
+
+	for _, label := range strings.Split(strings.TrimSuffix(host, "."), ".") {
+		if len(label) == 0 || len(label) > 63 || label[0] == '-' || label[len(label)-1] == '-' {
+			return false
+		}
+		for _, character := range label {
+			if !isHostnameCharacter(character) {
+				return false
+			}
+		}
+	}
+	return true
+}
+func isHostnameCharacter(character rune) bool {
+	return character >= 'a' && character <= 'z' || character >= 'A' && character <= 'Z' ||
+		character >= '0' && character <= '9' || character == '-' || character == '_'
+}
@@ -0,0 +1,91 @@
+package configo
+
+import (
+	"net"
+	"net/netip"
+	"testing"
+
+	"github.com/smartystreets/assertions/should"
+	"github.com/smartystreets/gunit"
+)
+
+func TestReaderNetworkFixture(t *testing.T) {
+	gunit.Run(new(ReaderNetworkFixture), t)
+}
+
+type ReaderNetworkFixture struct {
+	*gunit.Fixture
+
+	reader *Reader
+}
+
+func (this *ReaderNetworkFixture) Setup() {
+	this.reader = NewReader(NewDefaultSource(
+		Default("ips", "127.0.0.1", "::1"),
+		Default("cidrs", "10.0.0.0/8", "fd00::/8"),
+		Default("addr-ports", "127.0.0.1:8080", "[::1]:443"),
+		Default("host-ports", ":8080", "localhost:443", "[::1]:80"),
+		Default("bad", "not-an-address"),
+		Default("cidr-missing-bits", "10.0.0.0"),
+		Default("host-port-bad-port", "localhost:http"),
+		Default("host-port-port-overflow", "localhost:65536"),
+		Default("host-port-bad-hosts", "foo bar:80", "-leading:80", "trailing-.example.com:80", "a..b:80", "%zone:80"),
+		Default("host-port-hosts", "example.com.:80", "my_service:80", "[fe80::1%eth0]:80", "10.0.0.1:80"),
+	))
+}
+
+func (this *ReaderNetworkFixture) TestIPs() {
+	this.So(this.reader.IPs("ips"), should.Resemble, []net.IP{net.ParseIP("127.0.0.1"), net.ParseIP("::1")})
+	this.So(this.reader.IP("ips").String(), should.Equal, "127.0.0.1")
+	this.So(this.reader.IPDefault("bad", net.IPv4zero), should.Resemble, net.IPv4zero)
+	this.assertMalformed(this.reader.IPError("bad"))
+}
+
+func (this *ReaderNetworkFixture) TestIPNets() {
+	cidrs := this.reader.IPNets("cidrs")
+	this.So(len(cidrs), should.Equal, 2)
+	this.So(cidrs[0].String(), should.Equal, "10.0.0.0/8")
+	this.So(cidrs[1].Contains(net.ParseIP("fd00::1")), should.BeTrue)
+	this.assertMalformed(this.reader.IPNetError("cidr-missing-bits"))
+}
+
+func (this *ReaderNetworkFixture) TestPrefixes() {
+	this.So(this.reader.Prefixes("cidrs"), should.Resemble, []netip.Prefix{netip.MustParsePrefix("10.0.0.0/8"), netip.MustParsePrefix("fd00::/8")})
+	this.So(this.reader.Prefix("cidrs"), should.Resemble, netip.MustParsePrefix("10.0.0.0/8"))
+	this.assertMalformed(this.reader.PrefixError("cidr-missing-bits"))
+}
+
+func (this *ReaderNetworkFixture) TestAddrPorts() {
+	this.So(this.reader.AddrPorts("addr-ports"), should.Resemble, []netip.AddrPort{netip.MustParseAddrPort("127.0.0.1:8080"), netip.MustParseAddrPort("[::1]:443")})
+	this.So(this.reader.AddrPort("addr-ports").Port(), should.Equal, 8080)
+	this.assertMalformed(this.reader.AddrPortError("host-ports")) // ":8080" has no address
+}
+
+func (this *ReaderNetworkFixture) TestHostPorts() {
+	this.So(this.reader.HostPorts("host-ports"), should.Resemble, []string{":8080", "localhost:443", "[::1]:80"})
+	this.So(this.reader.HostPort("host-ports"), should.Equal, ":8080")
+	this.assertMalformed(this.reader.HostPortError("bad"))
+	this.assertMalformed(this.reader.HostPortError("host-port-bad-port"))
+	this.assertMalformed(this.reader.HostPortsError("host-port-port-overflow"))
+	this.So(this.reader.HostPorts("host-port-hosts"), should.Resemble, []string{"example.com.:80", "my_service:80", "[fe80::1%eth0]:80", "10.0.0.1:80"})
+	for _, value := range []string{"foo bar:80", "-leading:80", "trailing-.example.com:80", "a..b:80", "%zone:80"} {
+		_, err := parseHostPort(value)
+		this.So(err, should.NotBeNil)
+	}
+	this.assertMalformed(this.reader.HostPortsError("host-port-bad-hosts"))
+}
+
+func (this *ReaderNetworkFixture) TestNotFoundPanicFatal() {
+	_, err := this.reader.PrefixesError("missing")
+	this.So(err, should.Wrap, ErrKeyNotFound)
+	this.So(func() { this.reader.AddrPortPanic("bad") }, should.Panic)
+
+	var fatal error
+	this.reader.fatal = func(_ string, err error) { fatal = err }
+	this.So(this.reader.IPFatal("bad"), should.BeNil)
+	this.So(fatal, should.Wrap, ErrMalformedValue)
+}
+
+func (this *ReaderNetworkFixture) assertMalformed(_ interface{}, err error) {
+	this.So(err, should.Wrap, ErrMalformedValue)
+}
@@ -0,0 +1,1127 @@
+package configo
+
+import "strconv"
+
+/* ////////////////////////////////////////////////////////////////////////////////////////////////////////////////// */
+
+// Int64s returns all int64 values associated with the given key or returns nil
+// if the key does not exist or the values could not be parsed as int64s.
+func (this *Reader) Int64s(key string) []int64 {
+	value, _ := this.Int64sError(key)
+	return value
+}
+
+// Int64sError returns all int64 values associated with the given key with an error
+// if the key does not exist or the values could not be parsed as int64s (according to strconv.ParseInt).
+// Values which are out of range for the type result in ErrMalformedValue.
+func (this *Reader) Int64sError(key string) ([]int64, error) {
+	return parseAll(this, key, parseInt64)
+}
+
+// Int64sPanic returns all int64 values associated with the given key or panics
+// if the key does not exist or the values could not be parsed as int64s.
+func (this *Reader) Int64sPanic(key string) []int64 {
+	if value, err := this.Int64sError(key); err != nil {
+		panic(err)
+	} else {
+		return value
+	}
+}
+
+// Int64sFatal returns all int64 values associated with the given key or calls log.Fatal()
+// if the key does not exist or the values could not be parsed as int64s.
+func (this *Reader) Int64sFatal(key string) []int64 {
+	if value, err := this.Int64sError(key); err != nil {
+		this.fatal(key, err)
+		return nil
+	} else {
+		return value
+	}
+}
+
+// Int64sDefault returns all int64 values associated with the given key or returns provided defaults
+// if the key does not exist or the values could not be parsed as int64s.
+func (this *Reader) Int64sDefault(key string, Default []int64) []int64 {
+	if value, err := this.Int64sError(key); err != nil {
+		return Default
+	} else {
+		return value
+	}
+}
+
+/* ////////////////////////////////////////////////////////////////////////////////////////////////////////////////// */
+
+// Int64 returns the first int64 value associated with the given key or returns 0
+// if the key does not exist or the value could not be parsed as a int64.
+func (this *Reader) Int64(key string) int64 {
+	value, _ := this.Int64Error(key)
+	return value
+}
+
+// Int64Error returns the first int64 value associated with the given key with an error
+// if the key does not exist or the value could not be parsed as a int64 (according to strconv.ParseInt).
+// Values which are out of range for the type result in ErrMalformedValue.
+func (this *Reader) Int64Error(key string) (int64, error) {
+	return parseFirst(this, key, parseInt64)
+}
+
+// Int64Panic returns the first int64 value associated with the given key or panics
+// if the key does not exist or the value could not be parsed as a int64.
+func (this *Reader) Int64Panic(key string) int64 {
+	if value, err := this.Int64Error(key); err != nil {
+		panic(err)
+	} else {
+		return value
+	}
+}
+
+// Int64Fatal returns the first int64 value associated with the given key or calls log.Fatal()
+// if the key does not exist or the value could not be parsed as a int64.
+func (this *Reader) Int64Fatal(key string) int64 {
+	if value, err := this.Int64Error(key); err != nil {
+		this.fatal(key, err)
+		return 0
+	} else {
+		return value
+	}
+}
+
+// Int64Default returns the first int64 value associated with the given key or returns the provided default
+// if the key does not exist or the value could not be parsed as a int64.
+func (this *Reader) Int64Default(key string, Default int64) int64 {
+	if value, err := this.Int64Error(key); err != nil {
+		return Default
+	} else {
+		return value
+	}
+}
+
+/* ////////////////////////////////////////////////////////////////////////////////////////////////////////////////// */
+
+// Int32s returns all int32 values associated with the given key or returns nil
+// if the key does not exist or the values could not be parsed as int32s.
+func (this *Reader) Int32s(key string) []int32 {
+	value, _ := this.Int32sError(key)
+	return value
+}
+
+// Int32sError returns all int32 values associated with the given key with an error
+// if the key does not exist or the values could not be parsed as int32s (according to strconv.ParseInt).
+// Values which are out of range for the type result in ErrMalformedValue.
+func (this *Reader) Int32sError(key string) ([]int32, error) {
+	return parseAll(this, key, parseInt32)
+}
+
+// Int32sPanic returns all int32 values associated with the given key or panics
+// if the key does not exist or the values could not be parsed as int32s.
+func (this *Reader) Int32sPanic(key string) []int32 {
+	if value, err := this.Int32sError(key); err != nil {
+		panic(err)
+	} else {
+		return value
+	}
+}
+
+// Int32sFatal returns all int32 values associated with the given key or calls log.Fatal()
+// if the key does not exist or the values could not be parsed as int32s.
+func (this *Reader) Int32sFatal(key string) []int32 {
+	if value, err := this.Int32sError(key); err != nil {
+		this.fatal(key, err)
+		return nil
+	} else {
+		return value
+	}
+}
+
+// Int32sDefault returns all int32 values associated with the given key or returns provided defaults
+// if the key does not exist or the values could not be parsed as int32s.
+func (this *Reader) Int32sDefault(key string, Default []int32) []int32 {
+	if value, err := this.Int32sError(key); err != nil {
+		return Default
+	} else {
+		return value
+	}
+}
+
+/* ////////////////////////////////////////////////////////////////////////////////////////////////////////////////// */
+
+// Int32 returns the first int32 value associated with the given key or returns 0
+// if the key does not exist or the value could not be parsed as a int32.
+func (this *Reader) Int32(key string) int32 {
+	value, _ := this.Int32Error(key)
+	return value
+}
+
+// Int32Error returns the first int32 value associated with the given key with an error
+// if the key does not exist or the value could not be parsed as a int32 (according to strconv.ParseInt).
+// Values which are out of range for the type result in ErrMalformedValue.
+func (this *Reader) Int32Error(key string) (int32, error) {
+	return parseFirst(this, key, parseInt32)
+}
+
+// Int32Panic returns the first int32 value associated with the given key or panics
+// if the key does not exist or the value could not be parsed as a int32.
+func (this *Reader) Int32Panic(key string) int32 {
+	if value, err := this.Int32Error(key); err != nil {
+		panic(err)
+	} else {
+		return value
+	}
+}
+
+// Int32Fatal returns the first int32 value associated with the given key or calls log.Fatal()
+// if the key does not exist or the value could not be parsed as a int32.
+func (this *Reader) Int32Fatal(key string) int32 {
+	if value, err := this.Int32Error(key); err != nil {
+		this.fatal(key, err)
+		return 0
+	} else {
+		return value
+	}
+}
+
+// Int32Default returns the first int32 value associated with the given key or returns the provided default
+// if the key does not exist or the value could not be parsed as a int32.
+func (this *Reader) Int32Default(key string, Default int32) int32 {
+	if value, err := this.Int32Error(key); err != nil {
+		return Default
+	} else {
+		return value
+	}
+}
+
+/* ////////////////////////////////////////////////////////////////////////////////////////////////////////////////// */
+
+// Int16s returns all int16 values associated with the given key or returns nil
+// if the key does not exist or the values could not be parsed as int16s.
+func (this *Reader) Int16s(key string) []int16 {
+	value, _ := this.Int16sError(key)
+	return value
+}
+
+// Int16sError returns all int16 values associated with the given key with an error
+// if the key does not exist or the values could not be parsed as int16s (according to strconv.ParseInt).
+// Values which are out of range for the type result in ErrMalformedValue.
+func (this *Reader) Int16sError(key string) ([]int16, error) {
+	return parseAll(this, key, parseInt16)
+}
+
+// Int16sPanic returns all int16 values associated with the given key or panics
+// if the key does not exist or the values could not be parsed as int16s.
+func (this *Reader) Int16sPanic(key string) []int16 {
+	if value, err := this.Int16sError(key); err != nil {
+		panic(err)
+	} else {
+		return value
+	}
+}
+
+// Int16sFatal returns all int16 values associated with the given key or calls log.Fatal()
+// if the key does not exist or the values could not be parsed as int16s.
+func (this *Reader) Int16sFatal(key string) []int16 {
+	if value, err := this.Int16sError(key); err != nil {
+		this.fatal(key, err)
+		return nil
+	} else {
+		return value
+	}
+}
+
+// Int16sDefault returns all int16 values associated with the given key or returns provided defaults
+// if the key does not exist or the values could not be parsed as int16s.
+func (this *Reader) Int16sDefault(key string, Default []int16) []int16 {
+	if value, err := this.Int16sError(key); err != nil {
+		return Default
+	} else {
+		return value
+	}
+}
+
+/* ////////////////////////////////////////////////////////////////////////////////////////////////////////////////// */
+
+// Int16 returns the first int16 value associated with the given key or returns 0
+// if the key does not exist or the value could not be parsed as a int16.
+func (this *Reader) Int16(key string) int16 {
+	value, _ := this.Int16Error(key)
+	return value
+}
+
+// Int16Error returns the first int16 value associated with the given key with an error
+// if the key does not exist or the value could not be parsed as a int16 (according to strconv.ParseInt).
+// Values which are out of range for the type result in ErrMalformedValue.
+func (this *Reader) Int16Error(key string) (int16, error) {
+	return parseFirst(this, key, parseInt16)
+}
+
+// Int16Panic returns the first int16 value associated with the given key or panics
+// if the key does not exist or the value could not be parsed as a int16.
+func (this *Reader) Int16Panic(key string) int16 {
+	if value, err := this.Int16Error(key); err != nil {
+		panic(err)
+	} else {
+		return value
+	}
+}
+
+// Int16Fatal returns the first int16 value associated with the given key or calls log.Fatal()
+// if the key does not exist or the value could not be parsed as a int16.
+func (this *Reader) Int16Fatal(key string) int16 {
+	if value, err := this.Int16Error(key); err != nil {
+		this.fatal(key, err)
+		return 0
+	} else {
+		return value
+	}
+}
+
+// Int16Default returns the first int16 value associated with the given key or returns the provided default
+// if the key does not exist or the value could not be parsed as a int16.
+func (this *Reader) Int16Default(key string, Default int16) int16 {
+	if value, err := this.Int16Error(key); err != nil {
+		return Default
+	} else {
+		return value
+	}
+}
+
+/* ////////////////////////////////////////////////////////////////////////////////////////////////////////////////// */
+
+// Int8s returns all int8 values associated with the given key or returns nil
+// if the key does not exist or the values could not be parsed as int8s.
+func (this *Reader) Int8s(key string) []int8 {
+	value, _ := this.Int8sError(key)
+	return value
+}
+
+// Int8sError returns all int8 values associated with the given key with an error
+// if the key does not exist or the values could not be parsed as int8s (according to strconv.ParseInt).
+// Values which are out of range for the type result in ErrMalformedValue.
+func (this *Reader) Int8sError(key string) ([]int8, error) {
+	return parseAll(this, key, parseInt8)
+}
+
+// Int8sPanic returns all int8 values associated with the given key or panics
+// if the key does not exist or the values could not be parsed as int8s.
+func (this *Reader) Int8sPanic(key string) []int8 {
+	if value, err := this.Int8sError(key); err != nil {
+		panic(err)
+	} else {
+		return value
+	}
+}
+
+// Int8sFatal returns all int8 values associated with the given key or calls log.Fatal()
+// if the key does not exist or the values could not be parsed as int8s.
+func (this *Reader) Int8sFatal(key string) []int8 {
+	if value, err := this.Int8sError(key); err != nil {
+		this.fatal(key, err)
+		return nil
+	} else {
+		return value
+	}
+}
+
+// Int8sDefault returns all int8 values associated with the given key or returns provided defaults
+// if the key does not exist or the values could not be parsed as int8s.
+func (this *Reader) Int8sDefault(key string, Default []int8) []int8 {
+	if value, err := this.Int8sError(key); err != nil {
+		return Default
+	} else {
+		return value
+	}
+}
+
+/* ////////////////////////////////////////////////////////////////////////////////////////////////////////////////// */
+
+// Int8 returns the first int8 value associated with the given key or returns 0
+// if the key does not exist or the value could not be parsed as a int8.
+func (this *Reader) Int8(key string) int8 {
+	value, _ := this.Int8Error(key)
+	return value
+}
+
+// Int8Error returns the first int8 value associated with the given key with an error
+// if the key does not exist or the value could not be parsed as a int8 (according to strconv.ParseInt).
+// Values which are out of range for the type result in ErrMalformedValue.
+func (this *Reader) Int8Error(key string) (int8, error) {
+	return parseFirst(this, key, parseInt8)
+}
+
+// Int8Panic returns the first int8 value associated with the given key or panics
+// if the key does not exist or the value could not be parsed as a int8.
+func (this *Reader) Int8Panic(key string) int8 {
+	if value, err := this.Int8Error(key); err != nil {
+		panic(err)
+	} else {
+		return value
+	}
+}
+
+// Int8Fatal returns the first int8 value associated with the given key or calls log.Fatal()
+// if the key does not exist or the value could not be parsed as a int8.
+func (this *Reader) Int8Fatal(key string) int8 {
+	if value, err := this.Int8Error(key); err != nil {
+		this.fatal(key, err)
+		return 0
+	} else {
+		return value
+	}
+}
+
+// Int8Default returns the first int8 value associated with the given key or returns the provided default
+// if the key does not exist or the value could not be parsed as a int8.
+func (this *Reader) Int8Default(key string, Default int8) int8 {
+	if value, err := this.Int8Error(key); err != nil {
+		return Default
+	} else {
+		return value
+	}
+}
+
+/* ////////////////////////////////////////////////////////////////////////////////////////////////////////////////// */
+
+// Uints returns all uint values associated with the given key or returns nil
+// if the key does not exist or the values could not be parsed as unsigned integers.
+func (this *Reader) Uints(key string) []uint {
+	value, _ := this.UintsError(key)
+	return value
+}
+
+// UintsError returns all uint values associated with the given key with an error
+// if the key does not exist or the values could not be parsed as unsigned integers (according to strconv.ParseUint).
+// Values which are out of range for the type result in ErrMalformedValue.
+func (this *Reader) UintsError(key string) ([]uint, error) {
+	return parseAll(this, key, parseUint)
+}
+
+// UintsPanic returns all uint values associated with the given key or panics
+// if the key does not exist or the values could not be parsed as unsigned integers.
+func (this *Reader) UintsPanic(key string) []uint {
+	if value, err := this.UintsError(key); err != nil {
+		panic(err)
+	} else {
+		return value
+	}
+}
+
+// UintsFatal returns all uint values associated with the given key or calls log.Fatal()
+// if the key does not exist or the values could not be parsed as unsigned integers.
+func (this *Reader) UintsFatal(key string) []uint {
+	if value, err := this.UintsError(key); err != nil {
+		this.fatal(key, err)
+		return nil
+	} else {
+		return value
+	}
+}
+
+// UintsDefault returns all uint values associated with the given key or returns provided defaults
+// if the key does not exist or the values could not be parsed as unsigned integers.
+func (this *Reader) UintsDefault(key string, Default []uint) []uint {
+	if value, err := this.UintsError(key); err != nil {
+		return Default
+	} else {
+		return value
+	}
+}
+
+/* ////////////////////////////////////////////////////////////////////////////////////////////////////////////////// */
+
+// Uint returns the first uint value associated with the given key or returns 0
+// if the key does not exist or the value could not be parsed as a uint.
+func (this *Reader) Uint(key string) uint {
+	value, _ := this.UintError(key)
+	return value
+}
+
+// UintError returns the first uint value associated with the given key with an error
+// if the key does not exist or the value could not be parsed as a uint (according to strconv.ParseUint).
+// Values which are out of range for the type result in ErrMalformedValue.
+func (this *Reader) UintError(key string) (uint, error) {
+	return parseFirst(this, key, parseUint)
+}
+
+// UintPanic returns the first uint value associated with the given key or panics
+// if the key does not exist or the value could not be parsed as a uint.
+func (this *Reader) UintPanic(key string) uint {
+	if value, err := this.UintError(key); err != nil {
+		panic(err)
+	} else {
+		return value
+	}
+}
+
+// UintFatal returns the first uint value associated with the given key or calls log.Fatal()
+// if the key does not exist or the value could not be parsed as a uint.
+func (this *Reader) UintFatal(key string) uint {
+	if value, err := this.UintError(key); err != nil {
+		this.fatal(key, err)
+		return 0
+	} else {
+		return value
+	}
+}
+
+// UintDefault returns the first uint value associated with the given key or returns the provided default
+// if the key does not exist or the value could not be parsed as a uint.
+func (this *Reader) UintDefault(key string, Default uint) uint {
+	if value, err := this.UintError(key); err != nil {
+		return Default
+	} else {
+		return value
+	}
+}
+
+/* ////////////////////////////////////////////////////////////////////////////////////////////////////////////////// */
+
+// Uint64s returns all uint64 values associated with the given key or returns nil
+// if the key does not exist or the values could not be parsed as uint64s.
+func (this *Reader) Uint64s(key string) []uint64 {
+	value, _ := this.Uint64sError(key)
+	return value
+}
+
+// Uint64sError returns all uint64 values associated with the given key with an error
+// if the key does not exist or the values could not be parsed as uint64s (according to strconv.ParseUint).
+// Values which are out of range for the type result in ErrMalformedValue.
+func (this *Reader) Uint64sError(key string) ([]uint64, error) {
+	return parseAll(this, key, parseUint64)
+}
+
+// Uint64sPanic returns all uint64 values associated with the given key or panics
+// if the key does not exist or the values could not be parsed as uint64s.
+func (this *Reader) Uint64sPanic(key string) []uint64 {
+	if value, err := this.Uint64sError(key); err != nil {
+		panic(err)
+	} else {
+		return value
+	}
+}
+
+// Uint64sFatal returns all uint64 values associated with the given key or calls log.Fatal()
+// if the key does not exist or the values could not be parsed as uint64s.
+func (this *Reader) Uint64sFatal(key string) []uint64 {
+	if value, err := this.Uint64sError(key); err != nil {
+		this.fatal(key, err)
+		return nil
+	} else {
+		return value
+	}
+}
+
+// Uint64sDefault returns all uint64 values associated with the given key or returns provided defaults
+// if the key does not exist or the values could not be parsed as uint64s.
+func (this *Reader) Uint64sDefault(key string, Default []uint64) []uint64 {
+	if value, err := this.Uint64sError(key); err != nil {
+		return Default
+	} else {
+		return value
+	}
+}
+
+/* ////////////////////////////////////////////////////////////////////////////////////////////////////////////////// */
+
+// Uint64 returns the first uint64 value associated with the given key or returns 0
+// if the key does not exist or the value could not be parsed as a uint64.
+func (this *Reader) Uint64(key string) uint64 {
+	value, _ := this.Uint64Error(key)
+	return value
+}
+
+// Uint64Error returns the first uint64 value associated with the given key with an error
+// if the key does not exist or the value could not be parsed as a uint64 (according to strconv.ParseUint).
+// Values which are out of range for the type result in ErrMalformedValue.
+func (this *Reader) Uint64Error(key string) (uint64, error) {
+	return parseFirst(this, key, parseUint64)
+}
+
+// Uint64Panic returns the first uint64 value associated with the given key or panics
+// if the key does not exist or the value could not be parsed as a uint64.
+func (this *Reader) Uint64Panic(key string) uint64 {
+	if value, err := this.Uint64Error(key); err != nil {
+		panic(err)
+	} else {
+		return value
+	}
+}
+
+// Uint64Fatal returns the first uint64 value associated with the given key or calls log.Fatal()
+// if the key does not exist or the value could not be parsed as a uint64.
+func (this *Reader) Uint64Fatal(key string) uint64 {
+	if value, err := this.Uint64Error(key); err != nil {
+		this.fatal(key, err)
+		return 0
+	} else {
+		return value
+	}
+}
+
+// Uint64Default returns the first uint64 value associated with the given key or returns the provided default
+// if the key does not exist or the value could not be parsed as a uint64.
+func (this *Reader) Uint64Default(key string, Default uint64) uint64 {
+	if value, err := this.Uint64Error(key); err != nil {
+		return Default
+	} else {
+		return value
+	}
+}
+
+/* ////////////////////////////////////////////////////////////////////////////////////////////////////////////////// */
+
+// Uint32s returns all uint32 values associated with the given key or returns nil
+// if the key does not exist or the values could not be parsed as uint32s.
+func (this *Reader) Uint32s(key string) []uint32 {
+	value, _ := this.Uint32sError(key)
+	return value
+}
+
+// Uint32sError returns all uint32 values associated with the given key with an error
+// if the key does not exist or the values could not be parsed as uint32s (according to strconv.ParseUint).
+// Values which are out of range for the type result in ErrMalformedValue.
+func (this *Reader) Uint32sError(key string) ([]uint32, error) {
+	return parseAll(this, key, parseUint32)
+}
+
+// Uint32sPanic returns all uint32 values associated with the given key or panics
+// if the key does not exist or the values could not be parsed as uint32s.
+func (this *Reader) Uint32sPanic(key string) []uint32 {
+	if value, err := this.Uint32sError(key); err != nil {
+		panic(err)
+	} else {
+		return value
+	}
+}
+
+// Uint32sFatal returns all uint32 values associated with the given key or calls log.Fatal()
+// if the key does not exist or the values could not be parsed as uint32s.
+func (this *Reader) Uint32sFatal(key string) []uint32 {
+	if value, err := this.Uint32sError(key); err != nil {
+		this.fatal(key, err)
+		return nil
+	} else {
+		return value
+	}
+}
+
+// Uint32sDefault returns all uint32 values associated with the given key or returns provided defaults
+// if the key does not exist or the values could not be parsed as uint32s.
+func (this *Reader) Uint32sDefault(key string, Default []uint32) []uint32 {
+	if value, err := this.Uint32sError(key); err != nil {
+		return Default
+	} else {
+		return value
+	}
+}
+
+/* ////////////////////////////////////////////////////////////////////////////////////////////////////////////////// */
+
+// Uint32 returns the first uint32 value associated with the given key or returns 0
+// if the key does not exist or the value could not be parsed as a uint32.
+func (this *Reader) Uint32(key string) uint32 {
+	value, _ := this.Uint32Error(key)
+	return value
+}
+
+// Uint32Error returns the first uint32 value associated with the given key with an error
+// if the key does not exist or the value could not be parsed as a uint32 (according to strconv.ParseUint).
+// Values which are out of range for the type result in ErrMalformedValue.
+func (this *Reader) Uint32Error(key string) (uint32, error) {
+	return parseFirst(this, key, parseUint32)
+}
+
+// Uint32Panic returns the first uint32 value associated with the given key or panics
+// if the key does not exist or the value could not be parsed as a uint32.
+func (this *Reader) Uint32Panic(key string) uint32 {
+	if value, err := this.Uint32Error(key); err != nil {
+		panic(err)
+	} else {
+		return value
+	}
+}
+
+// Uint32Fatal returns the first uint32 value associated with the given key or calls log.Fatal()
+// if the key does not exist or the value could not be parsed as a uint32.
+func (this *Reader) Uint32Fatal(key string) uint32 {
+	if value, err := this.Uint32Error(key); err != nil {
+		this.fatal(key, err)
+		return 0
+	} else {
+		return value
+	}
+}
+
+// Uint32Default returns the first uint32 value associated with the given key or returns the provided default
+// if the key does not exist or the value could not be parsed as a uint32.
+func (this *Reader) Uint32Default(key string, Default uint32) uint32 {
+	if value, err := this.Uint32Error(key); err != nil {
+		return Default
+	} else {
+		return value
+	}
+}
+
+/* ////////////////////////////////////////////////////////////////////////////////////////////////////////////////// */
+
+// Uint16s returns all uint16 values associated with the given key or returns nil
+// if the key does not exist or the values could not be parsed as uint16s.
+func (this *Reader) Uint16s(key string) []uint16 {
+	value, _ := this.Uint16sError(key)
+	return value
+}
+
+// Uint16sError returns all uint16 values associated with the given key with an error
+// if the key does not exist or the values could not be parsed as uint16s (according to strconv.ParseUint).
+// Values which are out of range for the type result in ErrMalformedValue.
+func (this *Reader) Uint16sError(key string) ([]uint16, error) {
+	return parseAll(this, key, parseUint16)
+}
+
+// Uint16sPanic returns all uint16 values associated with the given key or panics
+// if the key does not exist or the values could not be parsed as uint16s.
+func (this *Reader) Uint16sPanic(key string) []uint16 {
+	if value, err := this.Uint16sError(key); err != nil {
+		panic(err)
+	} else {
+		return value
+	}
+}
+
+// Uint16sFatal returns all uint16 values associated with the given key or calls log.Fatal()
+// if the key does not exist or the values could not be parsed as uint16s.
+func (this *Reader) Uint16sFatal(key string) []uint16 {
+	if value, err := this.Uint16sError(key); err != nil {
+		this.fatal(key, err)
+		return nil
+	} else {
+		return value
+	}
+}
+
+// Uint16sDefault returns all uint16 values associated with the given key or returns provided defaults
+// if the key does not exist or the values could not be parsed as uint16s.
+func (this *Reader) Uint16sDefault(key string, Default []uint16) []uint16 {
+	if value, err := this.Uint16sError(key); err != nil {
+		return Default
+	} else {
+		return value
+	}
+}
+
+/* ////////////////////////////////////////////////////////////////////////////////////////////////////////////////// */
+
+// Uint16 returns the first uint16 value associated with the given key or returns 0
+// if the key does not exist or the value could not be parsed as a uint16.
+func (this *Reader) Uint16(key string) uint16 {
+	value, _ := this.Uint16Error(key)
+	return value
+}
+
+// Uint16Error returns the first uint16 value associated with the given key with an error
+// if the key does not exist or the value could not be parsed as a uint16 (according to strconv.ParseUint).
+// Values which are out of range for the type result in ErrMalformedValue.
+func (this *Reader) Uint16Error(key string) (uint16, error) {
+	return parseFirst(this, key, parseUint16)
+}
+
+// Uint16Panic returns the first uint16 value associated with the given key or panics
+// if the key does not exist or the value could not be parsed as a uint16.
+func (this *Reader) Uint16Panic(key string) uint16 {
+	if value, err := this.Uint16Error(key); err != nil {
+		panic(err)
+	} else {
+		return value
+	}
+}
+
+// Uint16Fatal returns the first uint16 value associated with the given key or calls log.Fatal()
+// if the key does not exist or the value could not be parsed as a uint16.
+func (this *Reader) Uint16Fatal(key string) uint16 {
+	if value, err := this.Uint16Error(key); err != nil {
+		this.fatal(key, err)
+		return 0
+	} else {
+		return value
+	}
+}
+
+// Uint16Default returns the first uint16 value associated with the given key or returns the provided default
+// if the key does not exist or the value could not be parsed as a uint16.
+func (this *Reader) Uint16Default(key string, Default uint16) uint16 {
+	if value, err := this.Uint16Error(key); err != nil {
+		return Default
+	} else {
+		return value
+	}
+}
+
+/* ////////////////////////////////////////////////////////////////////////////////////////////////////////////////// */
+
+// Uint8s returns all uint8 values associated with the given key or returns nil
+// if the key does not exist or the values could not be parsed as uint8s.
+func (this *Reader) Uint8s(key string) []uint8 {
+	value, _ := this.Uint8sError(key)
+	return value
+}
+
+// Uint8sError returns all uint8 values associated with the given key with an error
+// if the key does not exist or the values could not be parsed as uint8s (according to strconv.ParseUint).
+// Values which are out of range for the type result in ErrMalformedValue.
+func (this *Reader) Uint8sError(key string) ([]uint8, error) {
+	return parseAll(this, key, parseUint8)
+}
+
+// Uint8sPanic returns all uint8 values associated with the given key or panics
+// if the key does not exist or the values could not be parsed as uint8s.
+func (this *Reader) Uint8sPanic(key string) []uint8 {
+	if value, err := this.Uint8sError(key); err != nil {
+		panic(err)
+	} else {
+		return value
+	}
+}
+
+// Uint8sFatal returns all uint8 values associated with the given key or calls log.Fatal()
+// if the key does not exist or the values could not be parsed as uint8s.
+func (this *Reader) Uint8sFatal(key string) []uint8 {
+	if value, err := this.Uint8sError(key); err != nil {
+		this.fatal(key, err)
+		return nil
+	} else {
+		return value
+	}
+}
+
+// Uint8sDefault returns all uint8 values associated with the given key or returns provided defaults
+// if the key does not exist or the values could not be parsed as uint8s.
+func (this *Reader) Uint8sDefault(key string, Default []uint8) []uint8 {
+	if value, err := this.Uint8sError(key); err != nil {
+		return Default
+	} else {
+		return value
+	}
+}
+
+/* ////////////////////////////////////////////////////////////////////////////////////////////////////////////////// */
+
+// Uint8 returns the first uint8 value associated with the given key or returns 0
+// if the key does not exist or the value could not be parsed as a uint8.
+func (this *Reader) Uint8(key string) uint8 {
+	value, _ := this.Uint8Error(key)
+	return value
+}
+
+// Uint8Error returns the first uint8 value associated with the given key with an error
+// if the key does not exist or the value could not be parsed as a uint8 (according to strconv.ParseUint).
+// Values which are out of range for the type result in ErrMalformedValue.
+func (this *Reader) Uint8Error(key string) (uint8, error) {
+	return parseFirst(this, key, parseUint8)
+}
+
+// Uint8Panic returns the first uint8 value associated with the given key or panics
+// if the key does not exist or the value could not be parsed as a uint8.
+func (this *Reader) Uint8Panic(key string) uint8 {
+	if value, err := this.Uint8Error(key); err != nil {
+		panic(err)
+	} else {
+		return value
+	}
+}
+
+// Uint8Fatal returns the first uint8 value associated with the given key or calls log.Fatal()
+// if the key does not exist or the value could not be parsed as a uint8.
+func (this *Reader) Uint8Fatal(key string) uint8 {
+	if value, err := this.Uint8Error(key); err != nil {
+		this.fatal(key, err)
+		return 0
+	} else {
+		return value
+	}
+}
+
+// Uint8Default returns the first uint8 value associated with the given key or returns the provided default
+// if the key does not exist or the value could not be parsed as a uint8.
+func (this *Reader) Uint8Default(key string, Default uint8) uint8 {
+	if value, err := this.Uint8Error(key); err != nil {
+		return Default
+	} else {
+		return value
+	}
+}
+
+/* ////////////////////////////////////////////////////////////////////////////////////////////////////////////////// */
+
+// Float64s returns all float64 values associated with the given key or returns nil
+// if the key does not exist or the values could not be parsed as float64s.
+func (this *Reader) Float64s(key string) []float64 {
+	value, _ := this.Float64sError(key)
+	return value
+}
+
+// Float64sError returns all float64 values associated with the given key with an error
+// if the key does not exist or the values could not be parsed as float64s (according to strconv.ParseFloat).
+// Values which are out of range for the type result in ErrMalformedValue.
+func (this *Reader) Float64sError(key string) ([]float64, error) {
+	return parseAll(this, key, parseFloat64)
+}
+
+// Float64sPanic returns all float64 values associated with the given key or panics
+// if the key does not exist or the values could not be parsed as float64s.
+func (this *Reader) Float64sPanic(key string) []float64 {
+	if value, err := this.Float64sError(key); err != nil {
+		panic(err)
+	} else {
+		return value
+	}
+}
+
+// Float64sFatal returns all float64 values associated with the given key or calls log.Fatal()
+// if the key does not exist or the values could not be parsed as float64s.
+func (this *Reader) Float64sFatal(key string) []float64 {
+	if value, err := this.Float64sError(key); err != nil {
+		this.fatal(key, err)
+		return nil
+	} else {
+		return value
+	}
+}
+
+// Float64sDefault returns all float64 values associated with the given key or returns provided defaults
+// if the key does not exist or the values could not be parsed as float64s.
+func (this *Reader) Float64sDefault(key string, Default []float64) []float64 {
+	if value, err := this.Float64sError(key); err != nil {
+		return Default
+	} else {
+		return value
+	}
+}
+
+/* ////////////////////////////////////////////////////////////////////////////////////////////////////////////////// */
+
+// Float64 returns the first float64 value associated with the given key or returns 0
+// if the key does not exist or the value could not be parsed as a float64.
+func (this *Reader) Float64(key string) float64 {
+	value, _ := this.Float64Error(key)
+	return value
+}
+
+// Float64Error returns the first float64 value associated with the given key with an error
+// if the key does not exist or the value could not be parsed as a float64 (according to strconv.ParseFloat).
+// Values which are out of range for the type result in ErrMalformedValue.
+func (this *Reader) Float64Error(key string) (float64, error) {
+	return parseFirst(this, key, parseFloat64)
+}
+
+// Float64Panic returns the first float64 value associated with the given key or panics
+// if the key does not exist or the value could not be parsed as a float64.
+func (this *Reader) Float64Panic(key string) float64 {
+	if value, err := this.Float64Error(key); err != nil {
+		panic(err)
+	} else {
+		return value
+	}
+}
+
+// Float64Fatal returns the first float64 value associated with the given key or calls log.Fatal()
+// if the key does not exist or the value could not be parsed as a float64.
+func (this *Reader) Float64Fatal(key string) float64 {
+	if value, err := this.Float64Error(key); err != nil {
+		this.fatal(key, err)
+		return 0
+	} else {
+		return value
+	}
+}
+
+// Float64Default returns the first float64 value associated with the given key or returns the provided default
+// if the key does not exist or the value could not be parsed as a float64.
+func (this *Reader) Float64Default(key string, Default float64) float64 {
+	if value, err := this.Float64Error(key); err != nil {
+		return Default
+	} else {
+		return value
+	}
+}
+
+/* ////////////////////////////////////////////////////////////////////////////////////////////////////////////////// */
+
+// Float32s returns all float32 values associated with the given key or returns nil
+// if the key does not exist or the values could not be parsed as float32s.
+func (this *Reader) Float32s(key string) []float32 {
+	value, _ := this.Float32sError(key)
+	return value
+}
+
+// Float32sError returns all float32 values associated with the given key with an error
+// if the key does not exist or the values could not be parsed as float32s (according to strconv.ParseFloat).
+// Values which are out of range for the type result in ErrMalformedValue.
+func (this *Reader) Float32sError(key string) ([]float32, error) {
+	return parseAll(this, key, parseFloat32)
+}
+
+// Float32sPanic returns all float32 values associated with the given key or panics
+// if the key does not exist or the values could not be parsed as float32s.
+func (this *Reader) Float32sPanic(key string) []float32 {
+	if value, err := this.Float32sError(key); err != nil {
+		panic(err)
+	} else {
+		return value
+	}
+}
+
+// Float32sFatal returns all float32 values associated with the given key or calls log.Fatal()
+// if the key does not exist or the values could not be parsed as float32s.
+func (this *Reader) Float32sFatal(key string) []float32 {
+	if value, err := this.Float32sError(key); err != nil {
+		this.fatal(key, err)
+		return nil
+	} else {
+		return value
+	}
+}
+
+// Float32sDefault returns all float32 values associated with the given key or returns provided defaults
+// if the key does not exist or the values could not be parsed as float32s.
+func (this *Reader) Float32sDefault(key string, Default []float32) []float32 {
+	if value, err := this.Float32sError(key); err != nil {
+		return Default
+	} else {
+		return value
+	}
+}
+
+/* ////////////////////////////////////////////////////////////////////////////////////////////////////////////////// */
+
+// Float32 returns the first float32 value associated with the given key or returns 0
+// if the key does not exist or the value could not be parsed as a float32.
+func (this *Reader) Float32(key string) float32 {
+	value, _ := this.Float32Error(key)
+	return value
+}
+
+// Float32Error returns the first float32 value associated with the given key with an error
+// if the key does not exist or the value could not be parsed as a float32 (according to strconv.ParseFloat).
+// Values which are out of range for the type result in ErrMalformedValue.
+func (this *Reader) Float32Error(key string) (float32, error) {
+	return parseFirst(this, key, parseFloat32)
+}
+
+// Float32Panic returns the first float32 value associated with the given key or panics
+// if the key does not exist or the value could not be parsed as a float32.
+func (this *Reader) Float32Panic(key string) float32 {
+	if value, err := this.Float32Error(key); err != nil {
+		panic(err)
+	} else {
+		return value
+	}
+}
+
+// Float32Fatal returns the first float32 value associated with the given key or calls log.Fatal()
+// if the key does not exist or the value could not be parsed as a float32.
+func (this *Reader) Float32Fatal(key string) float32 {
+	if value, err := this.Float32Error(key); err != nil {
+		this.fatal(key, err)
+		return 0
+	} else {
+		return value
+	}
+}
+
+// Float32Default returns the first float32 value associated with the given key or returns the provided default
+// if the key does not exist or the value could not be parsed as a float32.
+func (this *Reader) Float32Default(key string, Default float32) float32 {
+	if value, err := this.Float32Error(key); err != nil {
+		return Default
+	} else {
+		return value
+	}
+}
+
+/* ////////////////////////////////////////////////////////////////////////////////////////////////////////////////// */
+
+func parseInt64(raw string) (int64, error) {
+	value, err := strconv.ParseInt(raw, 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	return value, nil
+}
+
+func parseInt32(raw string) (int32, error) {
+	value, err := strconv.ParseInt(raw, 10, 32)
+	if err != nil {
+		return 0, err
+	}
+	return int32(value), nil
+}
+
+func parseInt16(raw string) (int16, error) {
+	value, err := strconv.ParseInt(raw, 10, 16)
+	if err != nil {
+		return 0, err
+	}
+	return int16(value), nil
+}
+
+func parseInt8(raw string) (int8, error) {
+	value, err := strconv.ParseInt(raw, 10, 8)
+	if err != nil {
+		return 0, err
+	}
+	return int8(value), nil
+}
+
+func parseUint(raw string) (uint, error) {
+	value, err := strconv.ParseUint(raw, 10, 0)
+	if err != nil {
+		return 0, err
+	}
+	return uint(value), nil
+}
+
+func parseUint64(raw string) (uint64, error) {
+	value, err := strconv.ParseUint(raw, 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	return value, nil
+}
+
+func parseUint32(raw string) (uint32, error) {
+	value, err := strconv.ParseUint(raw, 10, 32)
+	if err != nil {
+		return 0, err
+	}
+	return uint32(value), nil
+}
+
+func parseUint16(raw string) (uint16, error) {
+	value, err := strconv.ParseUint(raw, 10, 16)
+	if err != nil {
+		return 0, err
+	}
+	return uint16(value), nil
+}
+
+func parseUint8(raw string) (uint8, error) {
+	value, err := strconv.ParseUint(raw, 10, 8)
+	if err != nil {
+		return 0, err
+	}
+	return uint8(value), nil
+}
+
+func parseFloat64(raw string) (float64, error) {
+	value, err := strconv.ParseFloat(raw, 64)
+	if err != nil {
+		return 0, err
+	}
+	return value, nil
+}
+
+func parseFloat32(raw string) (float32, error) {
+	value, err := strconv.ParseFloat(raw, 32)
+	if err != nil {
+		return 0, err
+	}
+	return float32(value), nil
+}
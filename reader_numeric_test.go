@@ -0,0 +1,126 @@
+package configo
+
+import (
+	"testing"
+
+	"github.com/smartystreets/assertions/should"
+	"github.com/smartystreets/gunit"
+)
+
+func TestReaderNumericFixture(t *testing.T) {
+	gunit.Run(new(ReaderNumericFixture), t)
+}
+
+type ReaderNumericFixture struct {
+	*gunit.Fixture
+
+	reader *Reader
+	key    string
+	err    error
+}
+
+func (this *ReaderNumericFixture) Setup() {
+	this.reader = NewReader(NewDefaultSource(
+		Default("int64", "9223372036854775807", "-9223372036854775808"),
+		Default("int64-overflow", "9223372036854775808"),
+		Default("int32", "2147483647"),
+		Default("int32-overflow", "2147483648"),
+		Default("int16", "-32768"),
+		Default("int16-overflow", "-32769"),
+		Default("int8", "127", "-128"),
+		Default("int8-overflow", "128"),
+		Default("uint", "42"),
+		Default("uint-negative", "-1"),
+		Default("uint64", "18446744073709551615"),
+		Default("uint64-overflow", "18446744073709551616"),
+		Default("uint32", "4294967295"),
+		Default("uint32-overflow", "4294967296"),
+		Default("uint16", "8080", "443"),
+		Default("uint16-overflow", "65536"),
+		Default("uint8", "255"),
+		Default("uint8-overflow", "256"),
+		Default("float64", "1.5", "-2.25e3"),
+		Default("float32", "3.25"),
+		Default("float32-overflow", "3.5e39"),
+		Default("bad", "not a number"),
+	))
+	this.reader.fatal = func(key string, err error) { this.key = key; this.err = err }
+}
+
+func (this *ReaderNumericFixture) TestSignedIntegers() {
+	this.So(this.reader.Int64s("int64"), should.Resemble, []int64{9223372036854775807, -9223372036854775808})
+	this.So(this.reader.Int64("int64"), should.Equal, int64(9223372036854775807))
+	this.So(this.reader.Int32("int32"), should.Equal, int32(2147483647))
+	this.So(this.reader.Int16("int16"), should.Equal, int16(-32768))
+	this.So(this.reader.Int8s("int8"), should.Resemble, []int8{127, -128})
+}
+
+func (this *ReaderNumericFixture) TestUnsignedIntegers() {
+	this.So(this.reader.Uint("uint"), should.Equal, uint(42))
+	this.So(this.reader.Uint64("uint64"), should.Equal, uint64(18446744073709551615))
+	this.So(this.reader.Uint32("uint32"), should.Equal, uint32(4294967295))
+	this.So(this.reader.Uint16s("uint16"), should.Resemble, []uint16{8080, 443})
+	this.So(this.reader.Uint8("uint8"), should.Equal, uint8(255))
+}
+
+func (this *ReaderNumericFixture) TestFloats() {
+	this.So(this.reader.Float64s("float64"), should.Resemble, []float64{1.5, -2250})
+	this.So(this.reader.Float64("float64"), should.Equal, 1.5)
+	this.So(this.reader.Float32("float32"), should.Equal, float32(3.25))
+}
+
+func (this *ReaderNumericFixture) TestOverflowIsMalformed() {
+	for _, err := range []error{
+		second(this.reader.Int64Error("int64-overflow")),
+		second(this.reader.Int32Error("int32-overflow")),
+		second(this.reader.Int16Error("int16-overflow")),
+		second(this.reader.Int8sError("int8-overflow")),
+		second(this.reader.UintError("uint-negative")),
+		second(this.reader.Uint64Error("uint64-overflow")),
+		second(this.reader.Uint32Error("uint32-overflow")),
+		second(this.reader.Uint16sError("uint16-overflow")),
+		second(this.reader.Uint8Error("uint8-overflow")),
+		second(this.reader.Float32Error("float32-overflow")),
+		second(this.reader.Float64sError("bad")),
+	} {
+		this.So(err, should.Wrap, ErrMalformedValue)
+	}
+}
+
+func (this *ReaderNumericFixture) TestNotFound() {
+	value, err := this.reader.Uint16Error("missing")
+	this.So(value, should.Equal, 0)
+	this.So(err, should.Wrap, ErrKeyNotFound)
+
+	values, err := this.reader.Float64sError("missing")
+	this.So(values, should.BeNil)
+	this.So(err, should.Wrap, ErrKeyNotFound)
+}
+
+func (this *ReaderNumericFixture) TestPanic() {
+	this.So(this.reader.Uint16Panic("uint16"), should.Equal, uint16(8080))
+	this.So(func() { this.reader.Uint16Panic("uint16-overflow") }, should.Panic)
+	this.So(func() { this.reader.Int64sPanic("missing") }, should.Panic)
+}
+
+func (this *ReaderNumericFixture) TestFatal() {
+	this.So(this.reader.Int32Fatal("int32"), should.Equal, int32(2147483647))
+	this.So(this.err, should.BeNil)
+
+	this.So(this.reader.Uint8Fatal("uint8-overflow"), should.Equal, 0)
+	this.So(this.key, should.Equal, "uint8-overflow")
+	this.So(this.err, should.Wrap, ErrMalformedValue)
+
+	this.So(this.reader.Float32sFatal("missing"), should.BeNil)
+	this.So(this.key, should.Equal, "missing")
+	this.So(this.err, should.Wrap, ErrKeyNotFound)
+}
+
+func (this *ReaderNumericFixture) TestDefault() {
+	this.So(this.reader.Uint16Default("uint16", 1), should.Equal, uint16(8080))
+	this.So(this.reader.Uint16Default("uint16-overflow", 1), should.Equal, uint16(1))
+	this.So(this.reader.Int64sDefault("missing", []int64{1}), should.Resemble, []int64{1})
+	this.So(this.reader.Float64Default("bad", 0.5), should.Equal, 0.5)
+}
+
+func second(_ interface{}, err error) error { return err }
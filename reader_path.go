@@ -0,0 +1,223 @@
+package configo
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// PathOption configures how Path (and its variants) resolves file paths.
+type PathOption func(*pathResolver)
+
+// PathMustExist causes paths which do not exist (according to os.Stat) to be reported as a *ParseError
+// (which wraps the error returned by os.Stat).
+func PathMustExist() PathOption {
+	return func(this *pathResolver) { this.mustExist = true }
+}
+
+// PathBaseDirectory resolves relative paths which weren't read from a file (see FileSource)
+// against the provided directory rather than the working directory.
+func PathBaseDirectory(directory string) PathOption {
+	return func(this *pathResolver) { this.fallback = directory }
+}
+
+/* ////////////////////////////////////////////////////////////////////////////////////////////////////////////////// */
+
+// Paths returns all file path values associated with the given key or returns nil
+// if the key does not exist or the values could not be resolved as file paths.
+// See PathsError for how the paths are resolved.
+func (this *Reader) Paths(key string, options ...PathOption) []string {
+	value, _ := this.PathsError(key, options...)
+	return value
+}
+
+// PathsError returns all file path values associated with the given key with an error
+// if the key does not exist or the values could not be resolved as file paths. Environment
+// variables (ex: $HOME or ${HOME}) are expanded (an undefined variable is reported as a *ParseError),
+// a leading ~ is replaced with the home directory of the current user, and relative paths read from
+// a file (such as a JSON config file, see FileSource) are resolved against the directory of that file.
+func (this *Reader) PathsError(key string, options ...PathOption) ([]string, error) {
+	raw, source, resolver, err := this.locatePaths(key, options)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make([]string, len(raw))
+	for i, r := range raw {
+		if values[i], err = resolver.resolve(r); err != nil {
+			return nil, this.parseError(key, source, r, err)
+		}
+	}
+
+	return values, nil
+}
+
+// PathsPanic returns all file path values associated with the given key or panics
+// if the key does not exist or the values could not be resolved as file paths.
+func (this *Reader) PathsPanic(key string, options ...PathOption) []string {
+	if value, err := this.PathsError(key, options...); err != nil {
+		panic(err)
+	} else {
+		return value
+	}
+}
+
+// PathsFatal returns all file path values associated with the given key or calls log.Fatal()
+// if the key does not exist or the values could not be resolved as file paths.
+func (this *Reader) PathsFatal(key string, options ...PathOption) []string {
+	if value, err := this.PathsError(key, options...); err != nil {
+		this.fatal(key, err)
+		return nil
+	} else {
+		return value
+	}
+}
+
+// PathsDefault returns all file path values associated with the given key or returns provided defaults
+// if the key does not exist or the values could not be resolved as file paths.
+func (this *Reader) PathsDefault(key string, Default []string, options ...PathOption) []string {
+	if value, err := this.PathsError(key, options...); err != nil {
+		return Default
+	} else {
+		return value
+	}
+}
+
+/* ////////////////////////////////////////////////////////////////////////////////////////////////////////////////// */
+
+// Path returns the first file path value associated with the given key or returns an empty string
+// if the key does not exist or the value could not be resolved as a file path.
+// See PathsError for how the path is resolved.
+func (this *Reader) Path(key string, options ...PathOption) string {
+	value, _ := this.PathError(key, options...)
+	return value
+}
+
+// PathError returns the first file path value associated with the given key with an error
+// if the key does not exist or the value could not be resolved as a file path.
+func (this *Reader) PathError(key string, options ...PathOption) (string, error) {
+	raw, source, resolver, err := this.locatePaths(key, options)
+	if err != nil {
+		return "", err
+	} else if len(raw) == 0 {
+		return "", nil
+	}
+
+	value, err := resolver.resolve(raw[0])
+	if err != nil {
+		return "", this.parseError(key, source, raw[0], err)
+	}
+	return value, nil
+}
+
+// PathPanic returns the first file path value associated with the given key or panics
+// if the key does not exist or the value could not be resolved as a file path.
+func (this *Reader) PathPanic(key string, options ...PathOption) string {
+	if value, err := this.PathError(key, options...); err != nil {
+		panic(err)
+	} else {
+		return value
+	}
+}
+
+// PathFatal returns the first file path value associated with the given key or calls log.Fatal()
+// if the key does not exist or the value could not be resolved as a file path.
+func (this *Reader) PathFatal(key string, options ...PathOption) string {
+	if value, err := this.PathError(key, options...); err != nil {
+		this.fatal(key, err)
+		return ""
+	} else {
+		return value
+	}
+}
+
+// PathDefault returns the first file path value associated with the given key or returns the provided default
+// if the key does not exist or the value could not be resolved as a file path.
+func (this *Reader) PathDefault(key string, Default string, options ...PathOption) string {
+	if value, err := this.PathError(key, options...); err != nil {
+		return Default
+	} else {
+		return value
+	}
+}
+
+/* ////////////////////////////////////////////////////////////////////////////////////////////////////////////////// */
+
+func (this *Reader) locatePaths(key string, options []PathOption) ([]string, Source, *pathResolver, error) {
+	raw, source, alias, err := this.locateAlias(key)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	resolver := &pathResolver{}
+	for _, option := range options {
+		option(resolver)
+	}
+
+	if file, ok := source.(FileSource); ok {
+		if filename := file.Filename(alias); len(filename) > 0 {
+			resolver.directory = filepath.Dir(filename)
+		}
+	}
+
+	return raw, source, resolver, nil
+}
+
+type pathResolver struct {
+	directory string
+	fallback  string
+	mustExist bool
+}
+
+func (this *pathResolver) resolve(raw string) (string, error) {
+	path, err := expandEnvironment(raw)
+	if err != nil {
+		return "", err
+	} else if len(path) == 0 {
+		return "", nil
+	}
+
+	if path == "~" || strings.HasPrefix(path, "~/") || strings.HasPrefix(path, "~"+string(filepath.Separator)) {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", err
+		}
+		path = filepath.Join(home, path[1:])
+	}
+
+	if !filepath.IsAbs(path) {
+		if len(this.directory) > 0 {
+			path = filepath.Join(this.directory, path)
+		} else if len(this.fallback) > 0 {
+			path = filepath.Join(this.fallback, path)
+		}
+	}
+	path = filepath.Clean(path)
+
+	if this.mustExist {
+		if _, err := os.Stat(path); err != nil {
+			return "", err
+		}
+	}
+
+	return path, nil
+}
+
+// expandEnvironment is like os.ExpandEnv but it reports any variable which isn't defined
+// (rather than silently replacing it with an empty string).
+func expandEnvironment(raw string) (string, error) {
+	var undefined []string
+	expanded := os.Expand(raw, func(name string) string {
+		value, found := os.LookupEnv(name)
+		if !found {
+			undefined = append(undefined, name)
+		}
+		return value
+	})
+
+	if len(undefined) > 0 {
+		return "", fmt.Errorf("undefined environment variable: %s", strings.Join(undefined, ", "))
+	}
+	return expanded, nil
+}
@@ -0,0 +1,119 @@
+package configo
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/smartystreets/assertions/should"
+	"github.com/smartystreets/gunit"
+)
+
+func TestReaderPathFixture(t *testing.T) {
+	gunit.Run(new(ReaderPathFixture), t)
+}
+
+type ReaderPathFixture struct {
+	*gunit.Fixture
+
+	directory tempDirectory
+	home      string
+	reader    *Reader
+}
+
+func (this *ReaderPathFixture) Setup() {
+	this.directory = newTempDirectory("readerPath")
+	this.home, _ = os.UserHomeDir()
+
+	filename := this.directory.write("config.json", []byte(`{"output": "logs/out.log", "config": "config.json", "missing": "missing.json"}`))
+	setEnvironment("CONFIGO_PATH_ROOT", "/var/lib")
+
+	this.reader = NewReader(
+		FromJSONFile(filename),
+		NewDefaultSource(
+			Default("relative", "data/../cache"),
+			Default("home", "~/.app/config.json", "~"),
+			Default("environment", "${CONFIGO_PATH_ROOT}/app", "$CONFIGO_PATH_ROOT/lib"),
+			Default("undefined", "${CONFIGO_PATH_ROOT}/app", "$CONFIGO_PATH_UNSET/app"),
+			Default("absolute", "/etc//app/"),
+			Default("empty", ""),
+		),
+	)
+}
+
+func (this *ReaderPathFixture) Teardown() {
+	this.directory.remove()
+	_ = os.Unsetenv("CONFIGO_PATH_ROOT")
+}
+
+func (this *ReaderPathFixture) TestRelativeToConfigFile() {
+	this.So(this.reader.Path("output"), should.Equal, this.directory.path(filepath.Join("logs", "out.log")))
+	this.So(this.reader.Path("output", PathBaseDirectory("/ignored")), should.Equal, this.directory.path(filepath.Join("logs", "out.log")))
+}
+
+func (this *ReaderPathFixture) TestRelativeToWorkingOrBaseDirectory() {
+	this.So(this.reader.Path("relative"), should.Equal, "cache")
+	this.So(this.reader.Path("relative", PathBaseDirectory("/srv")), should.Equal, "/srv/cache")
+}
+
+func (this *ReaderPathFixture) TestExpansion() {
+	this.So(this.reader.Paths("home"), should.Resemble, []string{filepath.Join(this.home, ".app", "config.json"), this.home})
+	this.So(this.reader.Paths("environment"), should.Resemble, []string{"/var/lib/app", "/var/lib/lib"})
+	this.So(this.reader.Path("absolute"), should.Equal, "/etc/app")
+	this.So(this.reader.Path("empty"), should.BeEmpty)
+}
+
+func (this *ReaderPathFixture) TestUndefinedEnvironmentVariable() {
+	values, err := this.reader.PathsError("undefined")
+	this.So(values, should.BeNil)
+	this.So(err, should.Wrap, ErrMalformedValue)
+	this.So(err.Error(), should.ContainSubstring, "undefined environment variable: CONFIGO_PATH_UNSET")
+
+	var parseError *ParseError
+	this.So(errors.As(err, &parseError), should.BeTrue)
+	this.So(parseError.Value, should.Equal, "$CONFIGO_PATH_UNSET/app")
+}
+
+func (this *ReaderPathFixture) TestMustExist() {
+	this.So(this.reader.Path("config", PathMustExist()), should.Equal, this.directory.path("config.json"))
+
+	value, err := this.reader.PathError("missing", PathMustExist())
+	this.So(value, should.BeEmpty)
+	this.So(err, should.Wrap, ErrMalformedValue)
+	this.So(this.reader.Path("missing"), should.Equal, this.directory.path("missing.json"))
+}
+
+func (this *ReaderPathFixture) TestVariants() {
+	_, err := this.reader.PathsError("nope")
+	this.So(err, should.Wrap, ErrKeyNotFound)
+	this.So(this.reader.PathDefault("missing", "/tmp/default", PathMustExist()), should.Equal, "/tmp/default")
+	this.So(this.reader.PathsDefault("nope", []string{"a"}), should.Resemble, []string{"a"})
+	this.So(func() { this.reader.PathPanic("missing", PathMustExist()) }, should.Panic)
+
+	var key string
+	this.reader.fatal = func(k string, e error) { err = e; key = k }
+	this.reader.PathsFatal("missing", PathMustExist())
+	this.So(key, should.Equal, "missing")
+	this.So(err, should.Wrap, ErrMalformedValue)
+}
+
+func (this *ReaderPathFixture) TestAliasResolvedAgainstConfigFile() {
+	this.reader.RegisterAlias("output", "log-file")
+	this.So(this.reader.Path("log-file"), should.Equal, this.directory.path(filepath.Join("logs", "out.log")))
+}
+
+func (this *ReaderPathFixture) TestResolvedAgainstFileWhichSuppliedAlias() {
+	first := newTempDirectory("readerPathFirst")
+	defer first.remove()
+	second := newTempDirectory("readerPathSecond")
+	defer second.remove()
+
+	reader := NewReader(MultiSource{
+		FromJSONFile(first.write("first.json", []byte(`{"other": "other.log"}`))),
+		FromJSONFile(second.write("second.json", []byte(`{"output": "out.log"}`))),
+	})
+	reader.RegisterAlias("output", "log-file")
+
+	this.So(reader.Path("log-file"), should.Equal, second.path("out.log"))
+}
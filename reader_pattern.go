@@ -0,0 +1,204 @@
+package configo
+
+import (
+	"regexp"
+	"text/template"
+)
+
+/* ////////////////////////////////////////////////////////////////////////////////////////////////////////////////// */
+
+// Regexps returns all regular expression values associated with the given key or returns nil
+// if the key does not exist or the values could not be parsed as regular expressions.
+// Values are compiled by regexp.Compile.
+func (this *Reader) Regexps(key string) []*regexp.Regexp {
+	value, _ := this.RegexpsError(key)
+	return value
+}
+
+// RegexpsError returns all regular expression values associated with the given key with an error
+// if the key does not exist or the values could not be parsed as regular expressions.
+func (this *Reader) RegexpsError(key string) ([]*regexp.Regexp, error) {
+	return parseAll(this, key, parseRegexp)
+}
+
+// RegexpsPanic returns all regular expression values associated with the given key or panics
+// if the key does not exist or the values could not be parsed as regular expressions.
+func (this *Reader) RegexpsPanic(key string) []*regexp.Regexp {
+	if value, err := this.RegexpsError(key); err != nil {
+		panic(err)
+	} else {
+		return value
+	}
+}
+
+// RegexpsFatal returns all regular expression values associated with the given key or calls log.Fatal()
+// if the key does not exist or the values could not be parsed as regular expressions.
+func (this *Reader) RegexpsFatal(key string) []*regexp.Regexp {
+	if value, err := this.RegexpsError(key); err != nil {
+		this.fatal(key, err)
+		return nil
+	} else {
+		return value
+	}
+}
+
+// RegexpsDefault returns all regular expression values associated with the given key or returns provided defaults
+// if the key does not exist or the values could not be parsed as regular expressions.
+func (this *Reader) RegexpsDefault(key string, Default []*regexp.Regexp) []*regexp.Regexp {
+	if value, err := this.RegexpsError(key); err != nil {
+		return Default
+	} else {
+		return value
+	}
+}
+
+/* ////////////////////////////////////////////////////////////////////////////////////////////////////////////////// */
+
+// Regexp returns the first regular expression value associated with the given key or returns the zero value
+// if the key does not exist or the value could not be parsed as a regular expression.
+// Values are compiled by regexp.Compile.
+func (this *Reader) Regexp(key string) *regexp.Regexp {
+	value, _ := this.RegexpError(key)
+	return value
+}
+
+// RegexpError returns the first regular expression value associated with the given key with an error
+// if the key does not exist or the value could not be parsed as a regular expression.
+func (this *Reader) RegexpError(key string) (*regexp.Regexp, error) {
+	return parseFirst(this, key, parseRegexp)
+}
+
+// RegexpPanic returns the first regular expression value associated with the given key or panics
+// if the key does not exist or the value could not be parsed as a regular expression.
+func (this *Reader) RegexpPanic(key string) *regexp.Regexp {
+	if value, err := this.RegexpError(key); err != nil {
+		panic(err)
+	} else {
+		return value
+	}
+}
+
+// RegexpFatal returns the first regular expression value associated with the given key or calls log.Fatal()
+// if the key does not exist or the value could not be parsed as a regular expression.
+func (this *Reader) RegexpFatal(key string) *regexp.Regexp {
+	if value, err := this.RegexpError(key); err != nil {
+		this.fatal(key, err)
+		return nil
+	} else {
+		return value
+	}
+}
+
+// RegexpDefault returns the first regular expression value associated with the given key or returns the provided default
+// if the key does not exist or the value could not be parsed as a regular expression.
+func (this *Reader) RegexpDefault(key string, Default *regexp.Regexp) *regexp.Regexp {
+	if value, err := this.RegexpError(key); err != nil {
+		return Default
+	} else {
+		return value
+	}
+}
+
+/* ////////////////////////////////////////////////////////////////////////////////////////////////////////////////// */
+
+// Templates returns all template values associated with the given key or returns nil
+// if the key does not exist or the values could not be parsed as templates.
+// Values are parsed by text/template, each as a template named after the key.
+func (this *Reader) Templates(key string) []*template.Template {
+	value, _ := this.TemplatesError(key)
+	return value
+}
+
+// TemplatesError returns all template values associated with the given key with an error
+// if the key does not exist or the values could not be parsed as templates.
+func (this *Reader) TemplatesError(key string) ([]*template.Template, error) {
+	return parseAll(this, key, templateParser(key))
+}
+
+// TemplatesPanic returns all template values associated with the given key or panics
+// if the key does not exist or the values could not be parsed as templates.
+func (this *Reader) TemplatesPanic(key string) []*template.Template {
+	if value, err := this.TemplatesError(key); err != nil {
+		panic(err)
+	} else {
+		return value
+	}
+}
+
+// TemplatesFatal returns all template values associated with the given key or calls log.Fatal()
+// if the key does not exist or the values could not be parsed as templates.
+func (this *Reader) TemplatesFatal(key string) []*template.Template {
+	if value, err := this.TemplatesError(key); err != nil {
+		this.fatal(key, err)
+		return nil
+	} else {
+		return value
+	}
+}
+
+// TemplatesDefault returns all template values associated with the given key or returns provided defaults
+// if the key does not exist or the values could not be parsed as templates.
+func (this *Reader) TemplatesDefault(key string, Default []*template.Template) []*template.Template {
+	if value, err := this.TemplatesError(key); err != nil {
+		return Default
+	} else {
+		return value
+	}
+}
+
+/* ////////////////////////////////////////////////////////////////////////////////////////////////////////////////// */
+
+// Template returns the first template value associated with the given key or returns the zero value
+// if the key does not exist or the value could not be parsed as a template.
+// Values are parsed by text/template, each as a template named after the key.
+func (this *Reader) Template(key string) *template.Template {
+	value, _ := this.TemplateError(key)
+	return value
+}
+
+// TemplateError returns the first template value associated with the given key with an error
+// if the key does not exist or the value could not be parsed as a template.
+func (this *Reader) TemplateError(key string) (*template.Template, error) {
+	return parseFirst(this, key, templateParser(key))
+}
+
+// TemplatePanic returns the first template value associated with the given key or panics
+// if the key does not exist or the value could not be parsed as a template.
+func (this *Reader) TemplatePanic(key string) *template.Template {
+	if value, err := this.TemplateError(key); err != nil {
+		panic(err)
+	} else {
+		return value
+	}
+}
+
+// TemplateFatal returns the first template value associated with the given key or calls log.Fatal()
+// if the key does not exist or the value could not be parsed as a template.
+func (this *Reader) TemplateFatal(key string) *template.Template {
+	if value, err := this.TemplateError(key); err != nil {
+		this.fatal(key, err)
+		return nil
+	} else {
+		return value
+	}
+}
+
+// TemplateDefault returns the first template value associated with the given key or returns the provided default
+// if the key does not exist or the value could not be parsed as a template.
+func (this *Reader) TemplateDefault(key string, Default *template.Template) *template.Template {
+	if value, err := this.TemplateError(key); err != nil {
+		return Default
+	} else {
+		return value
+	}
+}
+
+/* ////////////////////////////////////////////////////////////////////////////////////////////////////////////////// */
+
+func parseRegexp(raw string) (*regexp.Regexp, error) {
+	return regexp.Compile(raw)
+}
+
+func templateParser(name string) func(string) (*template.Template, error) {
+	return func(raw string) (*template.Template, error) { return template.New(name).Parse(raw) }
+}
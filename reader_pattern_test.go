@@ -0,0 +1,75 @@
+package configo
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+	"text/template"
+
+	"github.com/smartystreets/assertions/should"
+	"github.com/smartystreets/gunit"
+)
+
+func TestReaderPatternFixture(t *testing.T) {
+	gunit.Run(new(ReaderPatternFixture), t)
+}
+
+type ReaderPatternFixture struct {
+	*gunit.Fixture
+
+	reader *Reader
+}
+
+func (this *ReaderPatternFixture) Setup() {
+	this.reader = NewReader(NewDefaultSource(
+		Default("routes", `^/api/v\d+/`, `^/static/`),
+		Default("bad-route", `^/api/(`),
+		Default("greeting", "Hello, {{.Name}}!", "Bye, {{.Name}}."),
+		Default("bad-greeting", "Hello, {{.Name"),
+	))
+}
+
+func (this *ReaderPatternFixture) TestRegexps() {
+	this.So(this.reader.Regexp("routes").MatchString("/api/v2/users"), should.BeTrue)
+
+	routes := this.reader.Regexps("routes")
+	this.So(routes, should.HaveLength, 2)
+	this.So(routes[1].String(), should.Equal, `^/static/`)
+	this.So(Get[*regexp.Regexp](this.reader, "routes").String(), should.Equal, `^/api/v\d+/`)
+}
+
+func (this *ReaderPatternFixture) TestMalformedRegexp() {
+	value, err := this.reader.RegexpError("bad-route")
+	this.So(value, should.BeNil)
+	this.So(err, should.Wrap, ErrMalformedValue)
+	this.So(this.reader.RegexpsDefault("bad-route", nil), should.BeNil)
+	this.So(func() { this.reader.RegexpPanic("missing") }, should.Panic)
+}
+
+func (this *ReaderPatternFixture) TestTemplates() {
+	greeting := this.reader.Template("greeting")
+	this.So(greeting.Name(), should.Equal, "greeting")
+	this.So(this.execute(greeting), should.Equal, "Hello, World!")
+
+	templates := this.reader.Templates("greeting")
+	this.So(templates, should.HaveLength, 2)
+	this.So(this.execute(templates[1]), should.Equal, "Bye, World.")
+}
+
+func (this *ReaderPatternFixture) TestMalformedTemplate() {
+	value, err := this.reader.TemplateError("bad-greeting")
+	this.So(value, should.BeNil)
+	this.So(err, should.Wrap, ErrMalformedValue)
+
+	var key string
+	this.reader.fatal = func(k string, e error) { err = e; key = k }
+	this.reader.TemplatesFatal("bad-greeting")
+	this.So(key, should.Equal, "bad-greeting")
+	this.So(err, should.Wrap, ErrMalformedValue)
+}
+
+func (this *ReaderPatternFixture) execute(parsed *template.Template) string {
+	builder := new(strings.Builder)
+	this.So(parsed.Execute(builder, struct{ Name string }{Name: "World"}), should.BeNil)
+	return builder.String()
+}
@@ -0,0 +1,294 @@
+package configo
+
+import (
+	"math/big"
+	"strings"
+)
+
+/* ////////////////////////////////////////////////////////////////////////////////////////////////////////////////// */
+
+// ByteSizes returns all byte size values associated with the given key or returns nil
+// if the key does not exist or the values could not be parsed as byte sizes.
+// Byte sizes may include a decimal point and an SI (ex: 1.5GB, 10kB) or IEC (ex: 512MiB) suffix.
+func (this *Reader) ByteSizes(key string) []uint64 {
+	value, _ := this.ByteSizesError(key)
+	return value
+}
+
+// ByteSizesError returns all byte size values associated with the given key with an error
+// if the key does not exist or the values could not be parsed as byte sizes.
+func (this *Reader) ByteSizesError(key string) ([]uint64, error) {
+	return parseAll(this, key, parseByteSize)
+}
+
+// ByteSizesPanic returns all byte size values associated with the given key or panics
+// if the key does not exist or the values could not be parsed as byte sizes.
+func (this *Reader) ByteSizesPanic(key string) []uint64 {
+	if value, err := this.ByteSizesError(key); err != nil {
+		panic(err)
+	} else {
+		return value
+	}
+}
+
+// ByteSizesFatal returns all byte size values associated with the given key or calls log.Fatal()
+// if the key does not exist or the values could not be parsed as byte sizes.
+func (this *Reader) ByteSizesFatal(key string) []uint64 {
+	if value, err := this.ByteSizesError(key); err != nil {
+		this.fatal(key, err)
+		return nil
+	} else {
+		return value
+	}
+}
+
+// ByteSizesDefault returns all byte size values associated with the given key or returns provided defaults
+// if the key does not exist or the values could not be parsed as byte sizes.
+func (this *Reader) ByteSizesDefault(key string, Default []uint64) []uint64 {
+	if value, err := this.ByteSizesError(key); err != nil {
+		return Default
+	} else {
+		return value
+	}
+}
+
+/* ////////////////////////////////////////////////////////////////////////////////////////////////////////////////// */
+
+// ByteSize returns the first byte size value associated with the given key or returns the zero value
+// if the key does not exist or the value could not be parsed as a byte size.
+// Byte sizes may include a decimal point and an SI (ex: 1.5GB, 10kB) or IEC (ex: 512MiB) suffix.
+func (this *Reader) ByteSize(key string) uint64 {
+	value, _ := this.ByteSizeError(key)
+	return value
+}
+
+// ByteSizeError returns the first byte size value associated with the given key with an error
+// if the key does not exist or the value could not be parsed as a byte size.
+func (this *Reader) ByteSizeError(key string) (uint64, error) {
+	return parseFirst(this, key, parseByteSize)
+}
+
+// ByteSizePanic returns the first byte size value associated with the given key or panics
+// if the key does not exist or the value could not be parsed as a byte size.
+func (this *Reader) ByteSizePanic(key string) uint64 {
+	if value, err := this.ByteSizeError(key); err != nil {
+		panic(err)
+	} else {
+		return value
+	}
+}
+
+// ByteSizeFatal returns the first byte size value associated with the given key or calls log.Fatal()
+// if the key does not exist or the value could not be parsed as a byte size.
+func (this *Reader) ByteSizeFatal(key string) uint64 {
+	if value, err := this.ByteSizeError(key); err != nil {
+		this.fatal(key, err)
+		return 0
+	} else {
+		return value
+	}
+}
+
+// ByteSizeDefault returns the first byte size value associated with the given key or returns the provided default
+// if the key does not exist or the value could not be parsed as a byte size.
+func (this *Reader) ByteSizeDefault(key string, Default uint64) uint64 {
+	if value, err := this.ByteSizeError(key); err != nil {
+		return Default
+	} else {
+		return value
+	}
+}
+
+/* ////////////////////////////////////////////////////////////////////////////////////////////////////////////////// */
+
+// Quantities returns all quantity values associated with the given key or returns nil
+// if the key does not exist or the values could not be parsed as quantities.
+// Quantities may include a decimal point and an SI (ex: 10k, 2.5M) or IEC (ex: 4Ki) suffix.
+func (this *Reader) Quantities(key string) []int64 {
+	value, _ := this.QuantitiesError(key)
+	return value
+}
+
+// QuantitiesError returns all quantity values associated with the given key with an error
+// if the key does not exist or the values could not be parsed as quantities.
+func (this *Reader) QuantitiesError(key string) ([]int64, error) {
+	return parseAll(this, key, parseQuantity)
+}
+
+// QuantitiesPanic returns all quantity values associated with the given key or panics
+// if the key does not exist or the values could not be parsed as quantities.
+func (this *Reader) QuantitiesPanic(key string) []int64 {
+	if value, err := this.QuantitiesError(key); err != nil {
+		panic(err)
+	} else {
+		return value
+	}
+}
+
+// QuantitiesFatal returns all quantity values associated with the given key or calls log.Fatal()
+// if the key does not exist or the values could not be parsed as quantities.
+func (this *Reader) QuantitiesFatal(key string) []int64 {
+	if value, err := this.QuantitiesError(key); err != nil {
+		this.fatal(key, err)
+		return nil
+	} else {
+		return value
+	}
+}
+
+// QuantitiesDefault returns all quantity values associated with the given key or returns provided defaults
+// if the key does not exist or the values could not be parsed as quantities.
+func (this *Reader) QuantitiesDefault(key string, Default []int64) []int64 {
+	if value, err := this.QuantitiesError(key); err != nil {
+		return Default
+	} else {
+		return value
+	}
+}
+
+/* ////////////////////////////////////////////////////////////////////////////////////////////////////////////////// */
+
+// Quantity returns the first quantity value associated with the given key or returns the zero value
+// if the key does not exist or the value could not be parsed as a quantity.
+// Quantities may include a decimal point and an SI (ex: 10k, 2.5M) or IEC (ex: 4Ki) suffix.
+func (this *Reader) Quantity(key string) int64 {
+	value, _ := this.QuantityError(key)
+	return value
+}
+
+// QuantityError returns the first quantity value associated with the given key with an error
+// if the key does not exist or the value could not be parsed as a quantity.
+func (this *Reader) QuantityError(key string) (int64, error) {
+	return parseFirst(this, key, parseQuantity)
+}
+
+// QuantityPanic returns the first quantity value associated with the given key or panics
+// if the key does not exist or the value could not be parsed as a quantity.
+func (this *Reader) QuantityPanic(key string) int64 {
+	if value, err := this.QuantityError(key); err != nil {
+		panic(err)
+	} else {
+		return value
+	}
+}
+
+// QuantityFatal returns the first quantity value associated with the given key or calls log.Fatal()
+// if the key does not exist or the value could not be parsed as a quantity.
+func (this *Reader) QuantityFatal(key string) int64 {
+	if value, err := this.QuantityError(key); err != nil {
+		this.fatal(key, err)
+		return 0
+	} else {
+		return value
+	}
+}
+
+// QuantityDefault returns the first quantity value associated with the given key or returns the provided default
+// if the key does not exist or the value could not be parsed as a quantity.
+func (this *Reader) QuantityDefault(key string, Default int64) int64 {
+	if value, err := this.QuantityError(key); err != nil {
+		return Default
+	} else {
+		return value
+	}
+}
+
+/* ////////////////////////////////////////////////////////////////////////////////////////////////////////////////// */
+
+// byteSizeUnits are the (lower case) suffixes of byte sizes along with their multipliers.
+var byteSizeUnits = map[string]*big.Int{
+	"":    scale(1000, 0),
+	"b":   scale(1000, 0),
+	"k":   scale(1000, 1),
+	"kb":  scale(1000, 1),
+	"m":   scale(1000, 2),
+	"mb":  scale(1000, 2),
+	"g":   scale(1000, 3),
+	"gb":  scale(1000, 3),
+	"t":   scale(1000, 4),
+	"tb":  scale(1000, 4),
+	"p":   scale(1000, 5),
+	"pb":  scale(1000, 5),
+	"e":   scale(1000, 6),
+	"eb":  scale(1000, 6),
+	"ki":  scale(1024, 1),
+	"kib": scale(1024, 1),
+	"mi":  scale(1024, 2),
+	"mib": scale(1024, 2),
+	"gi":  scale(1024, 3),
+	"gib": scale(1024, 3),
+	"ti":  scale(1024, 4),
+	"tib": scale(1024, 4),
+	"pi":  scale(1024, 5),
+	"pib": scale(1024, 5),
+	"ei":  scale(1024, 6),
+	"eib": scale(1024, 6),
+}
+
+// quantityUnits are the (case sensitive) suffixes of quantities along with their multipliers.
+var quantityUnits = map[string]*big.Int{
+	"":   scale(1000, 0),
+	"k":  scale(1000, 1),
+	"K":  scale(1000, 1),
+	"M":  scale(1000, 2),
+	"G":  scale(1000, 3),
+	"T":  scale(1000, 4),
+	"P":  scale(1000, 5),
+	"E":  scale(1000, 6),
+	"Ki": scale(1024, 1),
+	"Mi": scale(1024, 2),
+	"Gi": scale(1024, 3),
+	"Ti": scale(1024, 4),
+	"Pi": scale(1024, 5),
+	"Ei": scale(1024, 6),
+}
+
+func scale(base, exponent int64) *big.Int {
+	return new(big.Int).Exp(big.NewInt(base), big.NewInt(exponent), nil)
+}
+
+func parseByteSize(raw string) (uint64, error) {
+	number, unit := splitQuantity(raw)
+	value, ok := scaleQuantity(number, byteSizeUnits[strings.ToLower(unit)])
+	if !ok || !value.IsUint64() {
+		return 0, ErrMalformedValue
+	}
+	return value.Uint64(), nil
+}
+
+func parseQuantity(raw string) (int64, error) {
+	number, unit := splitQuantity(raw)
+	value, ok := scaleQuantity(number, quantityUnits[unit])
+	if !ok || !value.IsInt64() {
+		return 0, ErrMalformedValue
+	}
+	return value.Int64(), nil
+}
+
+// splitQuantity separates the leading (signed, decimal) number from the unit that follows it.
+func splitQuantity(raw string) (number, unit string) {
+	raw = strings.TrimSpace(raw)
+	index := 0
+	if index < len(raw) && (raw[index] == '-' || raw[index] == '+') {
+		index++
+	}
+	for index < len(raw) && (raw[index] == '.' || ('0' <= raw[index] && raw[index] <= '9')) {
+		index++
+	}
+	return raw[:index], strings.TrimSpace(raw[index:])
+}
+
+// scaleQuantity multiplies the number by the multiplier, truncating any fractional remainder.
+func scaleQuantity(number string, multiplier *big.Int) (*big.Int, bool) {
+	if multiplier == nil || len(strings.Trim(number, "+-.")) == 0 {
+		return nil, false
+	}
+
+	value, ok := new(big.Rat).SetString(number)
+	if !ok {
+		return nil, false
+	}
+
+	value.Mul(value, new(big.Rat).SetInt(multiplier))
+	return new(big.Int).Quo(value.Num(), value.Denom()), true
+}
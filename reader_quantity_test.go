@@ -0,0 +1,65 @@
+package configo
+
+import (
+	"testing"
+
+	"github.com/smartystreets/assertions/should"
+	"github.com/smartystreets/gunit"
+)
+
+func TestReaderQuantityFixture(t *testing.T) {
+	gunit.Run(new(ReaderQuantityFixture), t)
+}
+
+type ReaderQuantityFixture struct {
+	*gunit.Fixture
+
+	reader *Reader
+}
+
+func (this *ReaderQuantityFixture) Setup() {
+	this.reader = NewReader(NewDefaultSource(
+		Default("sizes", "512MiB", "1.5GB", "10 kB", "42", "1b", "2Ki", "1.5KiB"),
+		Default("size-overflow", "16EiB"),
+		Default("size-negative", "-1KB"),
+		Default("size-bad-unit", "10XB"),
+		Default("size-bad-number", "MB"),
+		Default("quantities", "10k", "2.5M", "-3K", "4Ki", "7"),
+		Default("quantity-bad-unit", "10m"),
+		Default("quantity-overflow", "10E"),
+	))
+}
+
+func (this *ReaderQuantityFixture) TestByteSizes() {
+	this.So(this.reader.ByteSize("sizes"), should.Equal, uint64(512*1024*1024))
+	this.So(this.reader.ByteSizes("sizes"), should.Resemble, []uint64{536870912, 1500000000, 10000, 42, 1, 2048, 1536})
+}
+
+func (this *ReaderQuantityFixture) TestMalformedByteSizes() {
+	for _, key := range []string{"size-overflow", "size-negative", "size-bad-unit", "size-bad-number"} {
+		value, err := this.reader.ByteSizeError(key)
+		this.So(value, should.Equal, 0)
+		this.So(err, should.Wrap, ErrMalformedValue)
+	}
+}
+
+func (this *ReaderQuantityFixture) TestByteSizeVariants() {
+	_, err := this.reader.ByteSizeError("missing")
+	this.So(err, should.Wrap, ErrKeyNotFound)
+	this.So(this.reader.ByteSizeDefault("missing", 1024), should.Equal, uint64(1024))
+	this.So(func() { this.reader.ByteSizePanic("size-overflow") }, should.Panic)
+
+	var fatal error
+	this.reader.fatal = func(_ string, err error) { fatal = err }
+	this.reader.ByteSizesFatal("size-bad-unit")
+	this.So(fatal, should.Wrap, ErrMalformedValue)
+}
+
+func (this *ReaderQuantityFixture) TestQuantities() {
+	this.So(this.reader.Quantities("quantities"), should.Resemble, []int64{10000, 2500000, -3000, 4096, 7})
+	this.So(this.reader.Quantity("quantities"), should.Equal, int64(10000))
+	this.So(this.reader.QuantityDefault("quantity-bad-unit", 5), should.Equal, int64(5))
+
+	_, err := this.reader.QuantityError("quantity-overflow")
+	this.So(err, should.Wrap, ErrMalformedValue)
+}
@@ -0,0 +1,154 @@
+package configo
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Requirements declares the keys (and the types of their values) which a Reader must provide,
+// so that all of them can be checked at once (see Validate). Create them with Reader.Require.
+type Requirements struct {
+	reader *Reader
+	checks []requirement
+}
+
+type requirement struct {
+	key   string
+	check func(key string) error
+}
+
+// Require declares that the provided keys must exist (with values of any type).
+// Further requirements may be declared by chaining calls to the returned Requirements
+// (ex: reader.Require("name").Int("port").Duration("timeout").Validate()).
+func (this *Reader) Require(keys ...string) *Requirements {
+	requirements := &Requirements{reader: this}
+	return requirements.Strings(keys...)
+}
+
+// Strings declares that the provided keys must exist (with values of any type).
+func (this *Requirements) Strings(keys ...string) *Requirements {
+	return this.add(keys, func(key string) error { _, err := this.reader.StringsError(key); return err })
+}
+
+// Int declares that the provided keys must exist with values parsable as integers (see Reader.Ints).
+func (this *Requirements) Int(keys ...string) *Requirements {
+	return this.add(keys, func(key string) error { _, err := this.reader.IntsError(key); return err })
+}
+
+// Int64 declares that the provided keys must exist with values parsable as int64s (see Reader.Int64s).
+func (this *Requirements) Int64(keys ...string) *Requirements {
+	return this.add(keys, func(key string) error { _, err := this.reader.Int64sError(key); return err })
+}
+
+// Uint64 declares that the provided keys must exist with values parsable as uint64s (see Reader.Uint64s).
+func (this *Requirements) Uint64(keys ...string) *Requirements {
+	return this.add(keys, func(key string) error { _, err := this.reader.Uint64sError(key); return err })
+}
+
+// Float64 declares that the provided keys must exist with values parsable as float64s (see Reader.Float64s).
+func (this *Requirements) Float64(keys ...string) *Requirements {
+	return this.add(keys, func(key string) error { _, err := this.reader.Float64sError(key); return err })
+}
+
+// Bool declares that the provided keys must exist with values parsable as bools (see Reader.Bool).
+// Like the other requirements, every value of the key is checked (not just the first).
+func (this *Requirements) Bool(keys ...string) *Requirements {
+	return this.add(keys, func(key string) error { _, err := parseAll(this.reader, key, strconv.ParseBool); return err })
+}
+
+// Duration declares that the provided keys must exist with values parsable as Durations (see Reader.Duration).
+// Like the other requirements, every value of the key is checked (not just the first).
+func (this *Requirements) Duration(keys ...string) *Requirements {
+	return this.add(keys, func(key string) error { _, err := parseAll(this.reader, key, time.ParseDuration); return err })
+}
+
+// URL declares that the provided keys must exist with values parsable as URLs (see Reader.URLs).
+func (this *Requirements) URL(keys ...string) *Requirements {
+	return this.add(keys, func(key string) error { _, err := this.reader.URLsError(key); return err })
+}
+
+// Time declares that the provided key must exist with values parsable as Times using the provided format
+// (see Reader.Time). Like the other requirements, every value of the key is checked (not just the first).
+func (this *Requirements) Time(key, format string) *Requirements {
+	return this.add([]string{key}, func(key string) error {
+		_, err := parseAll(this.reader, key, func(raw string) (time.Time, error) { return parseTime(raw, format, this.reader.location) })
+		return err
+	})
+}
+
+// ByteSize declares that the provided keys must exist with values parsable as byte sizes (see Reader.ByteSizes).
+func (this *Requirements) ByteSize(keys ...string) *Requirements {
+	return this.add(keys, func(key string) error { _, err := this.reader.ByteSizesError(key); return err })
+}
+
+// IP declares that the provided keys must exist with values parsable as IP addresses (see Reader.IPs).
+func (this *Requirements) IP(keys ...string) *Requirements {
+	return this.add(keys, func(key string) error { _, err := this.reader.IPsError(key); return err })
+}
+
+// HostPort declares that the provided keys must exist with values parsable as host:port pairs (see Reader.HostPorts).
+func (this *Requirements) HostPort(keys ...string) *Requirements {
+	return this.add(keys, func(key string) error { _, err := this.reader.HostPortsError(key); return err })
+}
+
+// Regexp declares that the provided keys must exist with values which compile as regular expressions
+// (see Reader.Regexps).
+func (this *Requirements) Regexp(keys ...string) *Requirements {
+	return this.add(keys, func(key string) error { _, err := this.reader.RegexpsError(key); return err })
+}
+
+// Path declares that the provided key must exist with values which resolve as file paths (see Reader.Paths).
+func (this *Requirements) Path(key string, options ...PathOption) *Requirements {
+	return this.add([]string{key}, func(key string) error { _, err := this.reader.PathsError(key, options...); return err })
+}
+
+// Enum declares that the provided keys must exist with values allowed by the provided Enum (see Reader.Enums).
+func (this *Requirements) Enum(enum *Enum, keys ...string) *Requirements {
+	return this.add(keys, func(key string) error { _, err := this.reader.EnumsError(key, enum); return err })
+}
+
+// Check declares that the provided key must satisfy the provided callback, which is given the key and
+// should return the error of the appropriate Reader method (ex: reader.Uint16Error) or a custom error.
+// Because a *ValidationError lists each error as is, a custom error should identify the key.
+func (this *Requirements) Check(key string, check func(key string) error) *Requirements {
+	return this.add([]string{key}, check)
+}
+
+func (this *Requirements) add(keys []string, check func(string) error) *Requirements {
+	for _, key := range keys {
+		this.checks = append(this.checks, requirement{key: key, check: check})
+	}
+	return this
+}
+
+// Validate checks every requirement, in the order declared, and returns a *ValidationError listing every
+// key which is missing or malformed (or nil if all requirements are satisfied). Once a key fails a requirement,
+// any further requirements of the same key are skipped.
+func (this *Requirements) Validate() error {
+	failed := make(map[string]bool)
+	validation := &ValidationError{}
+
+	for _, requirement := range this.checks {
+		if failed[requirement.key] {
+			continue
+		}
+		if err := requirement.check(requirement.key); err != nil {
+			failed[requirement.key] = true
+			validation.Keys = append(validation.Keys, requirement.key)
+			validation.Errors = append(validation.Errors, err)
+		}
+	}
+
+	if len(validation.Errors) == 0 {
+		return nil
+	}
+	return validation
+}
+
+// ValidateFatal is like Validate but calls log.Fatal() with the *ValidationError, if any.
+func (this *Requirements) ValidateFatal() {
+	if err := this.Validate(); err != nil {
+		this.reader.fatal(strings.Join(err.(*ValidationError).Keys, ", "), err)
+	}
+}
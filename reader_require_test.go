@@ -0,0 +1,114 @@
+package configo
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/smartystreets/assertions/should"
+	"github.com/smartystreets/gunit"
+)
+
+func TestReaderRequireFixture(t *testing.T) {
+	gunit.Run(new(ReaderRequireFixture), t)
+}
+
+type ReaderRequireFixture struct {
+	*gunit.Fixture
+
+	reader *Reader
+}
+
+func (this *ReaderRequireFixture) Setup() {
+	this.reader = NewReader(NewDefaultSource(
+		Default("name", "app"),
+		Default("port", "8080"),
+		Default("timeout", "5s"),
+		Default("workers", "four"),
+		Default("level", "info"),
+		Default("routes", `^/api/`),
+		Default("max-size", "10MiB"),
+	))
+}
+
+func (this *ReaderRequireFixture) TestAllRequirementsSatisfied() {
+	err := this.reader.Require("name").
+		Int("port").
+		Duration("timeout").
+		ByteSize("max-size").
+		Regexp("routes").
+		Enum(NewEnum([]string{"debug", "info"}), "level").
+		Check("port", func(key string) error { _, err := this.reader.Uint16Error(key); return err }).
+		Validate()
+
+	this.So(err, should.BeNil)
+}
+
+func (this *ReaderRequireFixture) TestAllFailuresReportedTogether() {
+	err := this.reader.Require("name", "database").
+		Int("port", "workers").
+		Duration("timeout", "interval").
+		Validate()
+
+	var validation *ValidationError
+	this.So(errors.As(err, &validation), should.BeTrue)
+	this.So(validation.Keys, should.Resemble, []string{"database", "workers", "interval"})
+	this.So(validation.Errors, should.HaveLength, 3)
+	this.So(err, should.Wrap, ErrKeyNotFound)
+	this.So(err, should.Wrap, ErrMalformedValue)
+	this.So(err.Error(), should.Equal, "3 configuration key(s) missing or malformed:\n"+
+		`  - the specified key was not found: "database"`+"\n"+
+		`  - the specified value could not be parsed: key "workers" from DefaultSource has value "four": strconv.Atoi: parsing "four": invalid syntax`+"\n"+
+		`  - the specified key was not found: "interval"`)
+}
+
+func (this *ReaderRequireFixture) TestEveryValueChecked() {
+	reader := NewReader(NewDefaultSource(
+		Default("flags", "true", "maybe"),
+		Default("timeouts", "5s", "soon"),
+		Default("dates", "2024-01-02", "tomorrow"),
+	))
+
+	err := reader.Require().Bool("flags").Duration("timeouts").Time("dates", "2006-01-02").Validate()
+
+	this.So(err.(*ValidationError).Keys, should.Resemble, []string{"flags", "timeouts", "dates"})
+}
+
+func (this *ReaderRequireFixture) TestErrorsFoundByAs() {
+	err := this.reader.Require("database").Int("workers").Validate()
+
+	var keyError *KeyError
+	this.So(errors.As(err, &keyError), should.BeTrue)
+	this.So(keyError.Key, should.Equal, "database")
+
+	var parseError *ParseError
+	this.So(errors.As(err, &parseError), should.BeTrue)
+	this.So(parseError.Key, should.Equal, "workers")
+}
+
+func (this *ReaderRequireFixture) TestKeyReportedOnlyOnce() {
+	err := this.reader.Require("missing").Int("missing").Bool("missing").Validate()
+
+	this.So(err.(*ValidationError).Keys, should.Resemble, []string{"missing"})
+}
+
+func (this *ReaderRequireFixture) TestCustomCheckError() {
+	err := this.reader.Require().
+		Check("port", func(key string) error { return fmt.Errorf("%s: reserved port", key) }).
+		Validate()
+
+	this.So(err.Error(), should.EndWith, "\n  - port: reserved port")
+}
+
+func (this *ReaderRequireFixture) TestValidateFatal() {
+	var key string
+	var err error
+	this.reader.fatal = func(k string, e error) { err = e; key = k }
+
+	this.reader.Require("name").Int("port").ValidateFatal()
+	this.So(err, should.BeNil)
+
+	this.reader.Require("database").Int("workers").ValidateFatal()
+	this.So(key, should.Equal, "database, workers")
+	this.So(err, should.HaveSameTypeAs, &ValidationError{})
+}
@@ -0,0 +1,127 @@
+package configo
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// AutoTimeFormat may be provided as the format to Reader.Time (and its variants) to detect
+	// any of the following formats: time.RFC3339Nano (which includes time.RFC3339), DateTimeFormat,
+	// DateFormat, the format of time.Time.String(), and Unix timestamps in seconds or milliseconds.
+	// So that they can't be confused with dates (ex: "20150915"), only timestamps with at least 10
+	// digits (before any fractional part) are detected; use UnixTimeFormat for any others.
+	AutoTimeFormat = "auto"
+
+	// UnixTimeFormat may be provided as the format to Reader.Time (and its variants) to
+	// parse the number of seconds (optionally including a fractional part) since the Unix epoch.
+	UnixTimeFormat = "unix"
+
+	// UnixMilliTimeFormat may be provided as the format to Reader.Time (and its variants) to
+	// parse the number of milliseconds since the Unix epoch.
+	UnixMilliTimeFormat = "unixmilli"
+
+	timeFormatSeparator = "\n"
+	timeStringFormat    = "2006-01-02 15:04:05.999999999 -0700 MST"
+)
+
+var autoTimeFormats = []string{time.RFC3339Nano, DateTimeFormat, DateFormat, timeStringFormat}
+
+// TimeFormats combines several formats into a single format which may be provided to Reader.Time
+// (and its variants). Each format is attempted, in order, until the value is successfully parsed.
+func TimeFormats(formats ...string) string {
+	return strings.Join(formats, timeFormatSeparator)
+}
+
+// RegisterTimeLocation loads the named location (according to time.LoadLocation, ex: "America/Denver")
+// which is then used to interpret times parsed by Reader.Time and Get[time.Time] (and their variants)
+// that don't specify a time zone. Times parsed from Unix timestamps are returned in the location.
+// The default is UTC.
+func (this *Reader) RegisterTimeLocation(name string) error {
+	location, err := time.LoadLocation(name)
+	if err != nil {
+		return err
+	}
+	this.location = location
+	return nil
+}
+
+func parseTime(raw, format string, location *time.Location) (time.Time, error) {
+	if location == nil {
+		location = time.UTC
+	}
+
+	for _, layout := range timeLayouts(format) {
+		if parsed, ok := parseTimeLayout(raw, layout, location); ok {
+			return parsed, nil
+		}
+	}
+
+	return time.Time{}, ErrMalformedValue
+}
+func timeLayouts(format string) (layouts []string) {
+	for _, layout := range strings.Split(format, timeFormatSeparator) {
+		if layout == AutoTimeFormat {
+			layouts = append(layouts, autoTimeFormats...)
+			layouts = append(layouts, AutoTimeFormat) // detects Unix timestamps (see parseTimeLayout)
+		} else {
+			layouts = append(layouts, layout)
+		}
+	}
+	return layouts
+}
+
+func parseTimeLayout(raw, layout string, location *time.Location) (time.Time, bool) {
+	switch layout {
+	case AutoTimeFormat: // timestamps with 13 or more digits are considered to be milliseconds
+		parts := strings.SplitN(strings.TrimPrefix(raw, "-"), ".", 2)
+		if len(parts[0]) < minimumAutoUnixDigits {
+			return time.Time{}, false
+		} else if len(parts[0]) >= 13 && len(parts) == 1 {
+			return parseUnixTime(raw, time.Millisecond, location)
+		}
+		return parseUnixTime(raw, time.Second, location)
+	case UnixTimeFormat:
+		return parseUnixTime(raw, time.Second, location)
+	case UnixMilliTimeFormat:
+		return parseUnixTime(raw, time.Millisecond, location)
+	default:
+		parsed, err := time.ParseInLocation(layout, raw, location)
+		return parsed, err == nil
+	}
+}
+
+const minimumAutoUnixDigits = 10 // 2001-09-09 onward, in seconds
+
+func parseUnixTime(raw string, unit time.Duration, location *time.Location) (time.Time, bool) {
+	whole, fraction := raw, ""
+	if dot := strings.Index(raw, "."); dot >= 0 && unit == time.Second {
+		whole, fraction = raw[:dot], raw[dot+1:]
+	}
+
+	value, err := strconv.ParseInt(whole, 10, 64)
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	nanoseconds := int64(0)
+	if len(fraction) > 0 {
+		if len(fraction) > 9 {
+			fraction = fraction[:9]
+		}
+		parsed, err := strconv.ParseUint(fraction+strings.Repeat("0", 9-len(fraction)), 10, 64)
+		if err != nil {
+			return time.Time{}, false
+		}
+		nanoseconds = int64(parsed)
+		if strings.HasPrefix(whole, "-") {
+			nanoseconds = -nanoseconds
+		}
+	}
+
+	if unit == time.Millisecond {
+		return time.UnixMilli(value).In(location), true
+	}
+	return time.Unix(value, nanoseconds).In(location), true
+}
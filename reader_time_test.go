@@ -0,0 +1,97 @@
+package configo
+
+import (
+	"testing"
+	"time"
+	_ "time/tzdata"
+
+	"github.com/smartystreets/assertions/should"
+	"github.com/smartystreets/gunit"
+)
+
+func TestReaderTimeFixture(t *testing.T) {
+	gunit.Run(new(ReaderTimeFixture), t)
+}
+
+type ReaderTimeFixture struct {
+	*gunit.Fixture
+
+	reader *Reader
+}
+
+func (this *ReaderTimeFixture) Setup() {
+	this.reader = NewReader(NewDefaultSource(
+		Default("rfc3339", "2015-09-15T11:29:00Z"),
+		Default("rfc3339-nano", "2015-09-15T11:29:00.123456789-06:00"),
+		Default("date-time", "2015-09-15 11:29:00"),
+		Default("date", "2015-09-15"),
+		Default("string", "2015-09-15 11:29:00.5 +0000 UTC"),
+		Default("unix", "1442316540"),
+		Default("unix-fraction", "1442316540.25"),
+		Default("unix-milli", "1442316540250"),
+		Default("bad", "not a time"),
+		Default("yyyymmdd", "20150915"),
+	))
+}
+
+func (this *ReaderTimeFixture) TestAutoDetectedFormats() {
+	expected := time.Date(2015, 9, 15, 11, 29, 0, 0, time.UTC)
+
+	this.So(this.reader.Time("rfc3339", AutoTimeFormat), should.Equal, expected)
+	this.So(this.reader.Time("rfc3339-nano", AutoTimeFormat).Equal(time.Date(2015, 9, 15, 17, 29, 0, 123456789, time.UTC)), should.BeTrue)
+	this.So(this.reader.Time("date-time", AutoTimeFormat), should.Equal, expected)
+	this.So(this.reader.Time("date", AutoTimeFormat), should.Equal, time.Date(2015, 9, 15, 0, 0, 0, 0, time.UTC))
+	this.So(this.reader.Time("string", AutoTimeFormat).Equal(expected.Add(time.Millisecond*500)), should.BeTrue)
+	this.So(this.reader.Time("unix", AutoTimeFormat), should.Equal, expected)
+	this.So(this.reader.Time("unix-fraction", AutoTimeFormat), should.Equal, expected.Add(time.Millisecond*250))
+	this.So(this.reader.Time("unix-milli", AutoTimeFormat), should.Equal, expected.Add(time.Millisecond*250))
+
+	_, err := this.reader.TimeError("bad", AutoTimeFormat)
+	this.So(err, should.Wrap, ErrMalformedValue)
+}
+
+func (this *ReaderTimeFixture) TestAutoFormatIgnoresShortNumbers() {
+	for _, value := range []string{"20150915", "123456789.5", "86400"} {
+		_, err := parseTime(value, AutoTimeFormat, time.UTC)
+		this.So(err, should.Wrap, ErrMalformedValue)
+	}
+	_, err := this.reader.TimeError("yyyymmdd", AutoTimeFormat)
+	this.So(err, should.Wrap, ErrMalformedValue)
+	this.So(this.reader.Time("yyyymmdd", TimeFormats(AutoTimeFormat, "20060102")), should.Equal, time.Date(2015, 9, 15, 0, 0, 0, 0, time.UTC))
+	this.So(this.reader.Time("yyyymmdd", UnixTimeFormat), should.Equal, time.Unix(20150915, 0).UTC())
+}
+
+func (this *ReaderTimeFixture) TestUnixFormats() {
+	expected := time.Date(2015, 9, 15, 11, 29, 0, 0, time.UTC)
+
+	this.So(this.reader.Time("unix", UnixTimeFormat), should.Equal, expected)
+	this.So(this.reader.Time("unix-milli", UnixMilliTimeFormat), should.Equal, expected.Add(time.Millisecond*250))
+
+	_, err := this.reader.TimeError("date", UnixTimeFormat)
+	this.So(err, should.Wrap, ErrMalformedValue)
+}
+
+func (this *ReaderTimeFixture) TestMultipleFormats() {
+	format := TimeFormats(DateFormat, time.RFC3339)
+
+	this.So(this.reader.Time("date", format), should.Equal, time.Date(2015, 9, 15, 0, 0, 0, 0, time.UTC))
+	this.So(this.reader.Time("rfc3339", format), should.Equal, time.Date(2015, 9, 15, 11, 29, 0, 0, time.UTC))
+
+	_, err := this.reader.TimeError("date-time", format)
+	this.So(err, should.Wrap, ErrMalformedValue)
+}
+
+func (this *ReaderTimeFixture) TestRegisteredLocation() {
+	this.So(this.reader.RegisterTimeLocation("America/Denver"), should.BeNil)
+
+	parsed := this.reader.Time("date-time", DateTimeFormat)
+	this.So(parsed.Location().String(), should.Equal, "America/Denver")
+	this.So(parsed.UTC(), should.Equal, time.Date(2015, 9, 15, 17, 29, 0, 0, time.UTC))
+
+	this.So(this.reader.Time("unix", UnixTimeFormat).Location().String(), should.Equal, "America/Denver")
+	this.So(this.reader.Time("rfc3339", time.RFC3339).UTC(), should.Equal, time.Date(2015, 9, 15, 11, 29, 0, 0, time.UTC))
+}
+
+func (this *ReaderTimeFixture) TestUnknownLocation() {
+	this.So(this.reader.RegisterTimeLocation("Nowhere/Special"), should.NotBeNil)
+}
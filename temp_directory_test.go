@@ -0,0 +1,36 @@
+package configo
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+// tempDirectory is a temporary directory for tests which read files from disk.
+type tempDirectory string
+
+func newTempDirectory(prefix string) tempDirectory {
+	directory, err := ioutil.TempDir("", prefix)
+	if err != nil {
+		panic(err)
+	}
+	return tempDirectory(directory)
+}
+
+// path returns the full path of the named file (which may not exist) within the directory.
+func (this tempDirectory) path(name string) string {
+	return filepath.Join(string(this), name)
+}
+
+// write writes the contents to the named file within the directory and returns its full path.
+func (this tempDirectory) write(name string, contents []byte) string {
+	filename := this.path(name)
+	if err := ioutil.WriteFile(filename, contents, 0600); err != nil {
+		panic(err)
+	}
+	return filename
+}
+
+func (this tempDirectory) remove() {
+	_ = os.RemoveAll(string(this))
+}